@@ -0,0 +1,46 @@
+// Package atomicfile writes files so readers only ever see the old contents
+// or the new ones, never a partial write.
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Write writes the file through a temporary file in the same directory, so
+// an interrupted write never leaves a partial file behind to be mistaken for
+// a complete one. Missing directories are created.
+func Write(path string, contents []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(dir, "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(contents); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+
+		return err
+	}
+
+	// temporary files are only readable by their owner
+	if err := file.Chmod(0o644); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
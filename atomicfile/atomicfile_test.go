@@ -0,0 +1,41 @@
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		path = filepath.Join(dir, "nested", "bench.json")
+	)
+
+	if err := Write(path, []byte("first\n")); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	if err := Write(path, []byte("second\n")); err != nil {
+		t.Fatalf("Write() over an existing file error = %v", err)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil || string(contents) != "second\n" {
+		t.Fatalf("file = %q, %v, want %q", contents, err, "second\n")
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mode := info.Mode().Perm(); mode != 0o644 {
+		t.Errorf("mode = %v, want 0644", mode)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil || len(entries) != 1 {
+		t.Errorf("directory holds %d entries, want only the file", len(entries))
+	}
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"bbuck.dev/aoc2025/atomicfile"
+	"bbuck.dev/aoc2025/config"
+)
+
+// ErrNoSession is returned when no session token could be found.
+var ErrNoSession = errors.New("no session token, set AOC_SESSION or write it to the session file")
+
+// Client talks to the Advent of Code site, or anything pretending to be it.
+type Client struct {
+	BaseURL   string
+	UserAgent string
+	Session   string
+
+	HTTP *http.Client
+}
+
+// New creates a client from the remote settings, reading the session token
+// from the AOC_SESSION environment variable or the session file.
+func New(remote config.Remote) (*Client, error) {
+	session, err := LoadSession(remote.SessionFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		BaseURL:   strings.TrimSuffix(remote.BaseURL, "/"),
+		UserAgent: remote.UserAgent,
+		Session:   session,
+		HTTP: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}, nil
+}
+
+// LoadSession returns the session token from the AOC_SESSION environment
+// variable, falling back to the contents of the given file.
+func LoadSession(sessionFile string) (string, error) {
+	if session := strings.TrimSpace(os.Getenv("AOC_SESSION")); session != "" {
+		return session, nil
+	}
+
+	if sessionFile == "" {
+		return "", ErrNoSession
+	}
+
+	contents, err := os.ReadFile(sessionFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNoSession
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to read session file: %w", err)
+	}
+
+	session := strings.TrimSpace(string(contents))
+	if session == "" {
+		return "", ErrNoSession
+	}
+
+	return session, nil
+}
+
+// FetchInput downloads the puzzle input for the given day of the year.
+func (c *Client) FetchInput(ctx context.Context, year, day int) ([]byte, error) {
+	response, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%d/day/%d/input", year, day), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch input for %d day %d: %s: %s", year, day, response.Status, strings.TrimSpace(string(body)))
+	}
+
+	return body, nil
+}
+
+// CacheInput stores the puzzle input for the day of the year at the given
+// path, only downloading it when the file does not exist yet. The returned
+// bool reports whether the input was downloaded.
+func (c *Client) CacheInput(ctx context.Context, year, day int, path string) (bool, error) {
+	if _, err := os.Stat(path); err == nil {
+		return false, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	contents, err := c.FetchInput(ctx, year, day)
+	if err != nil {
+		return false, err
+	}
+
+	if err := atomicfile.Write(path, contents); err != nil {
+		return false, fmt.Errorf("failed to cache input: %w", err)
+	}
+
+	return true, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, form url.Values) (*http.Response, error) {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if form != nil {
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	request.Header.Set("User-Agent", c.UserAgent)
+	request.AddCookie(&http.Cookie{
+		Name:  "session",
+		Value: c.Session,
+	})
+
+	httpClient := c.HTTP
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return httpClient.Do(request)
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Client{
+		BaseURL:   server.URL,
+		UserAgent: "aoc-test",
+		Session:   "secret",
+		HTTP:      server.Client(),
+	}
+}
+
+func TestFetchInputSendsSessionAndUserAgent(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2025/day/3/input" {
+			t.Errorf("path = %q, want /2025/day/3/input", r.URL.Path)
+		}
+
+		if agent := r.Header.Get("User-Agent"); agent != "aoc-test" {
+			t.Errorf("User-Agent = %q, want aoc-test", agent)
+		}
+
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "secret" {
+			t.Errorf("session cookie = %v, %v, want secret", cookie, err)
+		}
+
+		w.Write([]byte("1 2 3\n"))
+	})
+
+	contents, err := client.FetchInput(t.Context(), 2025, 3)
+	if err != nil {
+		t.Fatalf("FetchInput() error = %v", err)
+	}
+
+	if string(contents) != "1 2 3\n" {
+		t.Errorf("FetchInput() = %q, want %q", contents, "1 2 3\n")
+	}
+}
+
+func TestCacheInputFailureWritesNothing(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "please log in", http.StatusBadRequest)
+	})
+
+	path := filepath.Join(t.TempDir(), "2025", "day1", "problem.in")
+	if _, err := client.CacheInput(t.Context(), 2025, 1, path); err == nil {
+		t.Fatal("CacheInput() error = nil, want an error for a 400 response")
+	}
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("input file exists after a failed download, stat error = %v", err)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err == nil && len(entries) > 0 {
+		t.Errorf("input directory has %d leftover files, want none", len(entries))
+	}
+}
+
+func TestCacheInputSkipsExistingFile(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte("downloaded\n"))
+	})
+
+	path := filepath.Join(t.TempDir(), "problem.in")
+
+	downloaded, err := client.CacheInput(t.Context(), 2025, 1, path)
+	if err != nil || !downloaded {
+		t.Fatalf("first CacheInput() = %v, %v, want true, nil", downloaded, err)
+	}
+
+	if err := os.WriteFile(path, []byte("kept\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	downloaded, err = client.CacheInput(t.Context(), 2025, 1, path)
+	if err != nil || downloaded {
+		t.Fatalf("second CacheInput() = %v, %v, want false, nil", downloaded, err)
+	}
+
+	if count := requests.Load(); count != 1 {
+		t.Errorf("server saw %d requests, want 1", count)
+	}
+
+	contents, _ := os.ReadFile(path)
+	if string(contents) != "kept\n" {
+		t.Errorf("cached input = %q, want it left untouched", contents)
+	}
+}
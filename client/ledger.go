@@ -0,0 +1,123 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"bbuck.dev/aoc2025/atomicfile"
+)
+
+// Attempt is a single answer that was submitted.
+type Attempt struct {
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	At      time.Time `json:"at"`
+}
+
+// Ledger is the local record of every answer submitted for each part, used
+// to avoid sending answers that are already known to be wrong.
+type Ledger struct {
+	path     string
+	Attempts map[string][]Attempt `json:"attempts"`
+}
+
+// OpenLedger loads the ledger stored at the given path, a missing file is an
+// empty ledger.
+func OpenLedger(path string) (*Ledger, error) {
+	ledger := &Ledger{
+		path:     path,
+		Attempts: make(map[string][]Attempt),
+	}
+
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ledger, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ledger: %w", err)
+	}
+
+	if err := json.Unmarshal(contents, ledger); err != nil {
+		return nil, fmt.Errorf("failed to parse ledger %s: %w", path, err)
+	}
+
+	if ledger.Attempts == nil {
+		ledger.Attempts = make(map[string][]Attempt)
+	}
+
+	return ledger, nil
+}
+
+// Save writes the ledger back to the path it was opened from.
+func (l *Ledger) Save() error {
+	contents, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return atomicfile.Write(l.path, append(contents, '\n'))
+}
+
+func ledgerKey(year, day, part int) string {
+	return fmt.Sprintf("%d/day%d/part%d", year, day, part)
+}
+
+// History returns every attempt recorded for the part, oldest first.
+func (l *Ledger) History(year, day, part int) []Attempt {
+	return l.Attempts[ledgerKey(year, day, part)]
+}
+
+// Record adds an attempt for the part.
+func (l *Ledger) Record(year, day, part int, attempt Attempt) {
+	key := ledgerKey(year, day, part)
+	l.Attempts[key] = append(l.Attempts[key], attempt)
+}
+
+// Check returns an error explaining why the answer should not be submitted,
+// either because the part is already solved, the answer was already rejected
+// or it falls outside the bounds set by earlier too high and too low answers.
+func (l *Ledger) Check(year, day, part int, answer string) error {
+	var low, high *big.Int
+	for _, attempt := range l.History(year, day, part) {
+		if attempt.Verdict == VerdictCorrect {
+			return fmt.Errorf("part %d is already solved with %s", part, attempt.Answer)
+		}
+
+		if attempt.Answer == answer && attempt.Verdict.Incorrect() {
+			return fmt.Errorf("%s was already submitted and is %s", answer, attempt.Verdict)
+		}
+
+		value, ok := new(big.Int).SetString(attempt.Answer, 10)
+		if !ok {
+			continue
+		}
+
+		if attempt.Verdict == VerdictTooLow && (low == nil || value.Cmp(low) > 0) {
+			low = value
+		}
+
+		if attempt.Verdict == VerdictTooHigh && (high == nil || value.Cmp(high) < 0) {
+			high = value
+		}
+	}
+
+	value, ok := new(big.Int).SetString(answer, 10)
+	if !ok {
+		return nil
+	}
+
+	if low != nil && value.Cmp(low) <= 0 {
+		return fmt.Errorf("%s is too low, %s was already too low", answer, low)
+	}
+
+	if high != nil && value.Cmp(high) >= 0 {
+		return fmt.Errorf("%s is too high, %s was already too high", answer, high)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLedgerCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts []Attempt
+		answer   string
+		wantErr  bool
+	}{
+		{
+			name:   "no attempts",
+			answer: "100",
+		},
+		{
+			name:     "already solved",
+			attempts: []Attempt{{Answer: "100", Verdict: VerdictCorrect}},
+			answer:   "200",
+			wantErr:  true,
+		},
+		{
+			name:     "already rejected",
+			attempts: []Attempt{{Answer: "abc", Verdict: VerdictWrong}},
+			answer:   "abc",
+			wantErr:  true,
+		},
+		{
+			name:     "rate limited answer may be resent",
+			attempts: []Attempt{{Answer: "100", Verdict: VerdictRateLimited}},
+			answer:   "100",
+		},
+		{
+			name:     "at the low bound",
+			attempts: []Attempt{{Answer: "100", Verdict: VerdictTooLow}},
+			answer:   "100",
+			wantErr:  true,
+		},
+		{
+			name:     "below the highest low bound",
+			attempts: []Attempt{{Answer: "50", Verdict: VerdictTooLow}, {Answer: "100", Verdict: VerdictTooLow}},
+			answer:   "75",
+			wantErr:  true,
+		},
+		{
+			name:     "at the high bound",
+			attempts: []Attempt{{Answer: "200", Verdict: VerdictTooHigh}},
+			answer:   "200",
+			wantErr:  true,
+		},
+		{
+			name:     "above the lowest high bound",
+			attempts: []Attempt{{Answer: "300", Verdict: VerdictTooHigh}, {Answer: "200", Verdict: VerdictTooHigh}},
+			answer:   "250",
+			wantErr:  true,
+		},
+		{
+			name:     "between the bounds",
+			attempts: []Attempt{{Answer: "100", Verdict: VerdictTooLow}, {Answer: "200", Verdict: VerdictTooHigh}},
+			answer:   "150",
+		},
+		{
+			name:     "bounds beyond int64",
+			attempts: []Attempt{{Answer: "99999999999999999999", Verdict: VerdictTooHigh}},
+			answer:   "100000000000000000000",
+			wantErr:  true,
+		},
+		{
+			name:     "non numeric answers skip the bounds",
+			attempts: []Attempt{{Answer: "100", Verdict: VerdictTooLow}},
+			answer:   "abc",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ledger, err := OpenLedger(filepath.Join(t.TempDir(), "answers.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, attempt := range test.attempts {
+				ledger.Record(2025, 1, 1, attempt)
+			}
+
+			err = ledger.Check(2025, 1, 1, test.answer)
+			if (err != nil) != test.wantErr {
+				t.Errorf("Check(%q) error = %v, want error %v", test.answer, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestLedgerKeepsPartsApart(t *testing.T) {
+	ledger, err := OpenLedger(filepath.Join(t.TempDir(), "answers.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ledger.Record(2025, 1, 1, Attempt{Answer: "100", Verdict: VerdictCorrect})
+	if err := ledger.Check(2025, 1, 2, "100"); err != nil {
+		t.Errorf("Check() for part 2 error = %v, want nil", err)
+	}
+
+	if err := ledger.Check(2024, 1, 1, "100"); err != nil {
+		t.Errorf("Check() for another year error = %v, want nil", err)
+	}
+}
+
+func TestLedgerSaveRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	ledger, err := OpenLedger(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ledger.Record(2025, 3, 2, Attempt{Answer: "42", Verdict: VerdictTooLow})
+	if err := ledger.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	reopened, err := OpenLedger(path)
+	if err != nil {
+		t.Fatalf("OpenLedger() error = %v", err)
+	}
+
+	history := reopened.History(2025, 3, 2)
+	if len(history) != 1 || history[0].Answer != "42" || history[0].Verdict != VerdictTooLow {
+		t.Errorf("History() = %+v, want the saved attempt", history)
+	}
+}
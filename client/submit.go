@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Verdict is the site's judgement of a submitted answer.
+type Verdict string
+
+const (
+	VerdictCorrect       Verdict = "correct"
+	VerdictWrong         Verdict = "wrong"
+	VerdictTooHigh       Verdict = "too_high"
+	VerdictTooLow        Verdict = "too_low"
+	VerdictRateLimited   Verdict = "rate_limited"
+	VerdictAlreadySolved Verdict = "already_solved"
+	VerdictUnknown       Verdict = "unknown"
+)
+
+// Incorrect reports whether the verdict rules the answer out.
+func (v Verdict) Incorrect() bool {
+	return v == VerdictWrong || v == VerdictTooHigh || v == VerdictTooLow
+}
+
+// Submission is the parsed response to submitting an answer.
+type Submission struct {
+	Verdict Verdict
+	// Message is the text of the response with the markup removed.
+	Message string
+}
+
+// Submit posts the answer for a part of a day of the year and parses the
+// verdict.
+func (c *Client) Submit(ctx context.Context, year, day, part int, answer string) (Submission, error) {
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {answer},
+	}
+
+	response, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day), form)
+	if err != nil {
+		return Submission{}, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return Submission{}, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return Submission{}, fmt.Errorf("failed to submit answer: %s", response.Status)
+	}
+
+	return ParseSubmission(string(body)), nil
+}
+
+var (
+	articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagPattern     = regexp.MustCompile(`<[^>]+>`)
+	spacePattern   = regexp.MustCompile(`\s+`)
+)
+
+// ParseSubmission reads the verdict out of the page returned after
+// submitting an answer.
+func ParseSubmission(page string) Submission {
+	message := page
+	if match := articlePattern.FindStringSubmatch(page); match != nil {
+		message = match[1]
+	}
+
+	message = tagPattern.ReplaceAllString(message, "")
+	message = spacePattern.ReplaceAllString(html.UnescapeString(message), " ")
+	message = strings.TrimSpace(message)
+
+	submission := Submission{
+		Verdict: VerdictUnknown,
+		Message: message,
+	}
+
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		submission.Verdict = VerdictCorrect
+
+	case strings.Contains(message, "too high"):
+		submission.Verdict = VerdictTooHigh
+
+	case strings.Contains(message, "too low"):
+		submission.Verdict = VerdictTooLow
+
+	case strings.Contains(message, "That's not the right answer"):
+		submission.Verdict = VerdictWrong
+
+	case strings.Contains(message, "You gave an answer too recently"):
+		submission.Verdict = VerdictRateLimited
+
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		submission.Verdict = VerdictAlreadySolved
+	}
+
+	return submission
+}
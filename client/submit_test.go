@@ -0,0 +1,98 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestParseSubmission(t *testing.T) {
+	page := func(message string) string {
+		return `<html><body><main><article><p>` + message + `</p></article></main></body></html>`
+	}
+
+	tests := []struct {
+		name string
+		page string
+		want Verdict
+	}{
+		{
+			name: "correct",
+			page: page(`That's the right answer! You are <span class="day-success">one gold star</span> closer.`),
+			want: VerdictCorrect,
+		},
+		{
+			name: "too high",
+			page: page(`That's not the right answer; your answer is too high. If you're stuck, <a href="/2025/about">read the about</a>.`),
+			want: VerdictTooHigh,
+		},
+		{
+			name: "too low",
+			page: page(`That's not the right answer; your answer is too low. Please wait one minute before trying again.`),
+			want: VerdictTooLow,
+		},
+		{
+			name: "wrong",
+			page: page(`That's not the right answer. If you're stuck, make sure you're using the full input data.`),
+			want: VerdictWrong,
+		},
+		{
+			name: "rate limited",
+			page: page(`You gave an answer too recently; you have to wait after submitting an answer before trying again. You have 42s left to wait.`),
+			want: VerdictRateLimited,
+		},
+		{
+			name: "already solved",
+			page: page(`You don't seem to be solving the right level. Did you already complete it?`),
+			want: VerdictAlreadySolved,
+		},
+		{
+			name: "unrecognised",
+			page: page(`Something else entirely.`),
+			want: VerdictUnknown,
+		},
+		{
+			name: "no article",
+			page: `That&apos;s the right answer!`,
+			want: VerdictCorrect,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submission := ParseSubmission(test.page)
+			if submission.Verdict != test.want {
+				t.Errorf("ParseSubmission() verdict = %q, want %q (message %q)", submission.Verdict, test.want, submission.Message)
+			}
+		})
+	}
+}
+
+func TestParseSubmissionStripsMarkup(t *testing.T) {
+	submission := ParseSubmission("<article><p>That's the   right\n answer! <a href=\"/\">[Return]</a></p></article>")
+	if want := "That's the right answer! [Return]"; submission.Message != want {
+		t.Errorf("ParseSubmission() message = %q, want %q", submission.Message, want)
+	}
+}
+
+func TestSubmitPostsAnswer(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2025/day/7/answer" {
+			t.Errorf("request = %s %s, want POST /2025/day/7/answer", r.Method, r.URL.Path)
+		}
+
+		if level, answer := r.FormValue("level"), r.FormValue("answer"); level != "2" || answer != "1234" {
+			t.Errorf("form = level %q answer %q, want level 2 answer 1234", level, answer)
+		}
+
+		w.Write([]byte("<article><p>That's the right answer!</p></article>"))
+	})
+
+	submission, err := client.Submit(t.Context(), 2025, 7, 2, "1234")
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+
+	if submission.Verdict != VerdictCorrect {
+		t.Errorf("Submit() verdict = %q, want %q", submission.Verdict, VerdictCorrect)
+	}
+}
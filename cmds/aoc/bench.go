@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/runner"
+)
+
+func benchCommand(args []string) error {
+	flags := flag.NewFlagSet("bench", flag.ExitOnError)
+	configuration := config.Bind(flags)
+	runs := flags.Int("runs", 10, "Number of times to parse and solve each part")
+	baselinePath := flags.String("baseline", "", "JSON file storing benchmark baselines, defaults to bench.json in the inputs directory")
+	threshold := flags.Float64("threshold", 0.1, "Slowdown of the median over the baseline reported as a regression, 0.1 is 10%")
+	update := flags.Bool("update", false, "Replace the stored baselines with this run's results")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *baselinePath == "" {
+		*baselinePath = filepath.Join(configuration.InputDir, "bench.json")
+	}
+
+	baseline, err := runner.OpenBaseline(*baselinePath)
+	if err != nil {
+		return err
+	}
+
+	var benchmarks []runner.Benchmark
+	err = profiled(*configuration, func() error {
+		var err error
+		benchmarks, err = runner.Bench(context.Background(), *configuration, *runs)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		regressed bool
+		stored    bool
+		encoder   = json.NewEncoder(os.Stdout)
+	)
+	for _, benchmark := range benchmarks {
+		comparison, added := baseline.Track(benchmark, *threshold, *update)
+		if comparison.Regressed {
+			regressed = true
+		}
+
+		if added {
+			stored = true
+		}
+
+		if configuration.Format == config.FormatJSON {
+			if err := encoder.Encode(comparison); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		fmt.Println(comparison)
+	}
+
+	if stored {
+		if err := baseline.Save(); err != nil {
+			return fmt.Errorf("failed to save baseline: %w", err)
+		}
+	}
+
+	if regressed {
+		return fmt.Errorf("one or more parts are more than %.0f%% slower than the baseline", *threshold*100)
+	}
+
+	if len(benchmarks) == 0 {
+		return errors.New("no parts are implemented")
+	}
+
+	return nil
+}
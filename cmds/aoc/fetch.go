@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"bbuck.dev/aoc2025/client"
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/input"
+)
+
+func fetchCommand(args []string) error {
+	flags := flag.NewFlagSet("fetch", flag.ExitOnError)
+	configuration := config.Bind(flags)
+	remote := config.BindRemote(flags)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if configuration.Day < 1 {
+		return errors.New("a day to fetch is required")
+	}
+
+	path := input.Path(*configuration, configuration.DayPath(), config.ProblemVariant)
+	if _, err := os.Stat(path); err == nil {
+		fmt.Fprintf(os.Stderr, "%s already exists, not fetching\n", path)
+
+		return nil
+	}
+
+	aoc, err := client.New(*remote)
+	if err != nil {
+		return err
+	}
+
+	if _, err := aoc.CacheInput(context.Background(), configuration.Year, configuration.Day, path); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "saved input to %s\n", path)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"bbuck.dev/aoc2025/input"
+)
+
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+var commands = []command{
+	{"run", "Solve a day's puzzle", runCommand},
+	{"params", "List the puzzle parameters a day declares", paramsCommand},
+	{"verify", "Compare a day's answers with the expected answer files", verifyCommand},
+	{"bench", "Benchmark a day and compare it with the stored baseline", benchCommand},
+	{"watch", "Rebuild and rerun a day whenever its source or inputs change", watchCommand},
+	{"fetch", "Download and cache a day's puzzle input", fetchCommand},
+	{"submit", "Submit a day's answer and record it in the ledger", submitCommand},
+	{"new", "Generate the solver package and inputs for a new day", newCommand},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	name, args := os.Args[1], os.Args[2:]
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+
+		if err := cmd.run(args); err != nil {
+			reportError(err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "unknown command %q\n", name)
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: aoc <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", cmd.name, cmd.summary)
+	}
+}
+
+// reportError prints the error, input problems are shown with the offending
+// line of input.
+func reportError(err error) {
+	var parseErr *input.ParseError
+	if errors.As(err, &parseErr) {
+		fmt.Fprintln(os.Stderr, parseErr.Diagnostic())
+
+		return
+	}
+
+	fmt.Fprintln(os.Stderr, err)
+}
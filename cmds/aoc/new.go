@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/scaffold"
+)
+
+func newCommand(args []string) error {
+	flags := flag.NewFlagSet("new", flag.ExitOnError)
+	configuration := config.Bind(flags)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	generator, err := scaffold.NewGenerator(".", configuration.InputDir)
+	if err != nil {
+		return err
+	}
+
+	created, err := generator.Generate(configuration.Year, configuration.Day)
+	for _, path := range created {
+		fmt.Fprintln(os.Stderr, "created", path)
+	}
+
+	return err
+}
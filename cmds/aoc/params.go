@@ -0,0 +1,28 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/solver"
+)
+
+func paramsCommand(args []string) error {
+	flags := flag.NewFlagSet("params", flag.ExitOnError)
+	configuration := config.Bind(flags)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if _, err := solver.Lookup(configuration.Year, configuration.Day); err != nil {
+		return err
+	}
+
+	for _, param := range solver.Params(configuration.Year, configuration.Day) {
+		fmt.Printf("%s\n    %s\n", param.ParamName(), param.ParamUsage())
+	}
+
+	return nil
+}
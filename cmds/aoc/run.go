@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"slices"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/progress"
+	"bbuck.dev/aoc2025/runner"
+)
+
+func runCommand(args []string) error {
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	configuration := config.Bind(flags)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var results []runner.Result
+	err := profiled(*configuration, func() error {
+		var err error
+		results, err = runner.Run(solveContext(), *configuration)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := runner.WriteResults(os.Stdout, configuration.Format, results); err != nil {
+		return err
+	}
+
+	if configuration.Timings {
+		if err := runner.WriteTimings(os.Stderr, results); err != nil {
+			return err
+		}
+	}
+
+	failed := slices.ContainsFunc(results, runner.Result.Failed)
+	if failed {
+		return errors.New("one or more parts failed")
+	}
+
+	return nil
+}
+
+// profiled runs the function while collecting the profiles requested by the
+// configuration.
+func profiled(configuration config.Config, run func() error) (err error) {
+	stop, err := runner.StartProfiling(configuration)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errors.Join(err, stop())
+	}()
+
+	return run()
+}
+
+// solveContext is the context solvers run under, their progress is shown on
+// standard error.
+func solveContext() context.Context {
+	return progress.NewContext(context.Background(), progress.NewDisplay(os.Stderr))
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"bbuck.dev/aoc2025/client"
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/runner"
+)
+
+func submitCommand(args []string) error {
+	flags := flag.NewFlagSet("submit", flag.ExitOnError)
+	configuration := config.Bind(flags)
+	remote := config.BindRemote(flags)
+	ledgerPath := flags.String("ledger", "", "JSON file recording submitted answers, defaults to answers.json in the inputs directory")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if configuration.Part == config.PartBoth {
+		return errors.New("a single part to submit is required")
+	}
+
+	if configuration.AllInputs {
+		return errors.New("answers can only be submitted for a single input")
+	}
+
+	// sample answers are never what the site wants, so unless another input
+	// was picked explicitly the real problem input is solved
+	if configuration.Input == "" && configuration.Variant == "" {
+		configuration.Solve = true
+	}
+
+	if *ledgerPath == "" {
+		*ledgerPath = filepath.Join(configuration.InputDir, "answers.json")
+	}
+
+	results, err := runner.Run(solveContext(), *configuration)
+	if err != nil {
+		return err
+	}
+
+	result := results[0]
+	if result.Err != nil {
+		return fmt.Errorf("failed to solve part %d: %w", result.Part, result.Err)
+	}
+
+	answer := result.AnswerString()
+
+	ledger, err := client.OpenLedger(*ledgerPath)
+	if err != nil {
+		return err
+	}
+
+	if err := ledger.Check(configuration.Year, configuration.Day, result.Part, answer); err != nil {
+		return fmt.Errorf("refusing to submit: %w", err)
+	}
+
+	aoc, err := client.New(*remote)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "submitting %s for %d day %d part %d\n", answer, configuration.Year, configuration.Day, result.Part)
+
+	submission, err := aoc.Submit(context.Background(), configuration.Year, configuration.Day, result.Part, answer)
+	if err != nil {
+		return err
+	}
+
+	ledger.Record(configuration.Year, configuration.Day, result.Part, client.Attempt{
+		Answer:  answer,
+		Verdict: submission.Verdict,
+		At:      time.Now().UTC(),
+	})
+	if err := ledger.Save(); err != nil {
+		return fmt.Errorf("failed to save ledger: %w", err)
+	}
+
+	fmt.Println(submission.Verdict)
+	fmt.Fprintln(os.Stderr, submission.Message)
+
+	if submission.Verdict != client.VerdictCorrect {
+		return fmt.Errorf("answer was not accepted: %s", submission.Verdict)
+	}
+
+	return nil
+}
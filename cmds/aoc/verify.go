@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/runner"
+)
+
+func verifyCommand(args []string) error {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	configuration := config.Bind(flags)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var checks []runner.Check
+	err := profiled(*configuration, func() error {
+		var err error
+		checks, err = runner.Verify(solveContext(), *configuration)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		failed  bool
+		results = make([]runner.Result, 0, len(checks))
+	)
+	for _, check := range checks {
+		fmt.Println(check)
+
+		if check.Status == runner.StatusFail {
+			failed = true
+		}
+
+		results = append(results, check.Result)
+	}
+
+	if configuration.Timings {
+		if err := runner.WriteTimings(os.Stderr, results); err != nil {
+			return err
+		}
+	}
+
+	if failed {
+		return errors.New("one or more answers did not match")
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/runner"
+	"bbuck.dev/aoc2025/scaffold"
+)
+
+// watchFlags are the flags only understood by watch, every other flag is
+// passed on to the rebuilt run command.
+var watchFlags = map[string]bool{
+	"interval": true,
+	"format":   true,
+	"inputs":   true,
+}
+
+func watchCommand(args []string) error {
+	flags := flag.NewFlagSet("watch", flag.ExitOnError)
+	configuration := config.Bind(flags)
+	interval := flags.Duration("interval", 500*time.Millisecond, "How often to check for changes")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	root, err := scaffold.FindModuleRoot(".")
+	if err != nil {
+		return err
+	}
+
+	var (
+		sourceDir = filepath.Join(root, "days", filepath.FromSlash(configuration.DayPath()))
+		inputDir  = filepath.Join(configuration.InputDir, filepath.FromSlash(input.DayDir(*configuration, configuration.DayPath())))
+	)
+	if _, err := os.Stat(sourceDir); err != nil {
+		return fmt.Errorf("no solver for %d day %d: %w", configuration.Year, configuration.Day, err)
+	}
+
+	// stopping on an interrupt rather than being killed by it lets the build
+	// directory be removed, interrupts stay caught until it has been
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	buildDir, err := os.MkdirTemp("", "aoc-watch")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(buildDir)
+
+	var (
+		binary = filepath.Join(buildDir, "aoc")
+		// the inputs directory is passed on resolved, so the run reads the
+		// directory being watched
+		runArgs  = []string{"run", "-format", "json", "-inputs", configuration.InputDir}
+		previous = make(map[string]string)
+		seen     map[string]time.Time
+	)
+	flags.Visit(func(f *flag.Flag) {
+		if !watchFlags[f.Name] {
+			runArgs = append(runArgs, fmt.Sprintf("-%s=%s", f.Name, f.Value))
+		}
+	})
+
+	fmt.Fprintf(os.Stderr, "watching %s and %s\n", sourceDir, inputDir)
+	for {
+		current, err := snapshot(sourceDir, inputDir)
+		if err != nil {
+			return err
+		}
+
+		if !maps.Equal(seen, current) {
+			seen = current
+
+			answers, err := rebuildAndRun(ctx, root, binary, runArgs)
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			} else {
+				writeAnswers(answers, previous)
+				previous = answers
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-time.After(*interval):
+		}
+	}
+}
+
+// snapshot records the modification time of every file in the directories,
+// a directory that does not exist is treated as empty.
+func snapshot(dirs ...string) (map[string]time.Time, error) {
+	files := make(map[string]time.Time)
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			if err != nil || entry.IsDir() {
+				return err
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+
+			files[path] = info.ModTime()
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+// rebuildAndRun builds the aoc command from the module at root and runs it,
+// returning each answer keyed by input and part. Solver output on standard
+// error is passed through.
+func rebuildAndRun(ctx context.Context, root, binary string, args []string) (map[string]string, error) {
+	build := exec.CommandContext(ctx, "go", "build", "-o", binary, "./cmds/aoc")
+	build.Dir = root
+	if output, err := build.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("build failed:\n%s", strings.TrimSuffix(string(output), "\n"))
+	}
+
+	var (
+		stdout = new(bytes.Buffer)
+		run    = exec.CommandContext(ctx, binary, args...)
+	)
+	run.Stdout = stdout
+	run.Stderr = os.Stderr
+
+	// failed parts still produce records, only missing output is an error
+	runErr := run.Run()
+
+	answers := make(map[string]string)
+	decoder := json.NewDecoder(stdout)
+	for decoder.More() {
+		var record runner.Record
+		if err := decoder.Decode(&record); err != nil {
+			return nil, fmt.Errorf("failed to read results: %w", err)
+		}
+
+		answer := record.Answer
+		if record.Error != "" {
+			answer = "error: " + record.Error
+		}
+
+		answers[fmt.Sprintf("%s part %d", record.Input, record.Part)] = answer
+	}
+
+	if len(answers) == 0 && runErr != nil {
+		return nil, fmt.Errorf("run failed: %w", runErr)
+	}
+
+	return answers, nil
+}
+
+// writeAnswers prints the new answers beside the previous ones, marking the
+// answers that changed.
+func writeAnswers(answers, previous map[string]string) {
+	fmt.Printf("\n%s\n", time.Now().Format(time.TimeOnly))
+
+	table := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(table, "\tprevious\tnew\t")
+	for _, key := range slices.Sorted(maps.Keys(answers)) {
+		before, ok := previous[key]
+		if !ok {
+			before = "-"
+		}
+
+		var marker string
+		if ok && before != answers[key] {
+			marker = "changed"
+		}
+
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\n", key, before, answers[key], marker)
+	}
+
+	table.Flush()
+}
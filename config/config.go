@@ -1,18 +1,205 @@
 package config
 
-import "flag"
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// DefaultInputDir is where inputs are read from when neither the -inputs flag
+// nor the AOC_INPUTS environment variable is set.
+const DefaultInputDir = "inputs"
+
+// DefaultYear is the event solved when neither the -year flag nor the
+// AOC_YEAR environment variable is set.
+const DefaultYear = 2025
+
+// SampleVariant and ProblemVariant name the input variants read by default,
+// the worked example from the puzzle text and the real puzzle input.
+const (
+	SampleVariant  = "sample"
+	ProblemVariant = "problem"
+)
 
 type Config struct {
-	Solve bool
+	Year   int
+	Day    int
+	Part   Part
+	Solve  bool
+	Format Format
+
+	// Input is an explicit file to read input from, "-" reads standard input.
+	Input string
+	// Variant names the input file of the day to read, without the ".in"
+	// extension. Empty means "sample", or "problem" when Solve is set.
+	Variant string
+	// AllInputs runs every input variant of the day instead of a single one.
+	AllInputs bool
+	// InputDir is the directory holding the per-day input directories. Bind
+	// makes it absolute against the working directory, so every command
+	// reads the same directory whatever it resolves other paths against.
+	InputDir string
+	// InputFS replaces InputDir when set, allowing inputs to be read from an
+	// embed.FS. It is laid out like InputDir and cannot be set by flags.
+	InputFS fs.FS
+
+	// Params are the values given for the puzzle parameters days declare.
+	Params Params
+
+	// Verbosity is how much solvers log, 0 for warnings only, 1 to narrate
+	// what they are doing and 2 to add debug dumps of their internals.
+	Verbosity int
+
+	// Timeout limits how long solving each part may take, zero means no
+	// limit.
+	Timeout time.Duration
+
+	// Timings reports how long each phase of a run took on standard error.
+	Timings bool
+	// CPUProfile, MemProfile and Trace are files to write the matching
+	// runtime profile to, empty disables the profile.
+	CPUProfile string
+	MemProfile string
+	Trace      string
+}
+
+// Bind registers the configuration flags on the given flag set. The returned
+// config is populated once the flag set has been parsed.
+func Bind(flags *flag.FlagSet) *Config {
+	configuration := new(Config)
+
+	flags.IntVar(&configuration.Year, "year", envIntOr("AOC_YEAR", DefaultYear), "The event year the day belongs to")
+	flags.IntVar(&configuration.Day, "day", 0, "The day to run")
+	flags.Var(&configuration.Part, "part", "The part to solve: 1, 2 or both")
+	flags.BoolVar(&configuration.Solve, "solve", false, "Use the real problem input as input")
+	flags.Var(&configuration.Format, "format", "Output format for answers: text or json")
+	flags.StringVar(&configuration.Input, "input", "", "Read input from this file instead, - reads stdin")
+	flags.StringVar(&configuration.Variant, "variant", "", "Name of the day's input file to read, like edge-case")
+	flags.BoolVar(&configuration.AllInputs, "all-inputs", false, "Run every *.in input of the day")
+	// left relative only if the working directory cannot be found
+	inputDir := &inputDirFlag{dir: &configuration.InputDir}
+	inputDir.Set(envOr("AOC_INPUTS", DefaultInputDir))
+	flags.Var(inputDir, "inputs", "Directory containing the inputs for each day")
+	flags.Var(paramFlag{&configuration.Params}, "param", "Set a puzzle parameter of the day as name=value, may be repeated")
+	flags.Var(&paramsFileFlag{params: &configuration.Params}, "params", "JSON file of puzzle parameters by day, like {\"2025/day8\": {\"target-junctions\": 10}}")
+	flags.BoolFunc("v", "Log what solvers are doing", func(string) error {
+		configuration.Verbosity = max(configuration.Verbosity, 1)
+
+		return nil
+	})
+	flags.BoolFunc("vv", "Log what solvers are doing and dump their internals", func(string) error {
+		configuration.Verbosity = 2
+
+		return nil
+	})
+	flags.DurationVar(&configuration.Timeout, "timeout", 0, "Give up solving a part after this long, like 30s")
+	flags.BoolVar(&configuration.Timings, "timings", false, "Report the time spent reading, parsing and solving")
+	flags.StringVar(&configuration.CPUProfile, "cpuprofile", "", "Write a CPU profile to this file")
+	flags.StringVar(&configuration.MemProfile, "memprofile", "", "Write a heap profile to this file")
+	flags.StringVar(&configuration.Trace, "trace", "", "Write an execution trace to this file")
+
+	return configuration
+}
+
+// inputDirFlag sets the inputs directory, resolved against the working
+// directory. It shows the directory as it was given.
+type inputDirFlag struct {
+	dir  *string
+	text string
+}
+
+func (f *inputDirFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return f.text
+}
+
+func (f *inputDirFlag) Set(text string) error {
+	f.text = text
+	*f.dir = text
+
+	dir, err := filepath.Abs(text)
+	if err != nil {
+		return err
+	}
+
+	*f.dir = dir
+
+	return nil
+}
+
+// DayName returns the name of the configured day as used for its package and
+// input directory, like "day5".
+func (c Config) DayName() string {
+	return fmt.Sprintf("day%d", c.Day)
+}
+
+// DayPath returns the configured day qualified by its year, like
+// "2025/day5", which is where its inputs live inside the inputs directory.
+func (c Config) DayPath() string {
+	return path.Join(strconv.Itoa(c.Year), c.DayName())
+}
+
+// InputName describes the input being read in results, the variant name or
+// the explicit input path.
+func (c Config) InputName() string {
+	if c.Input != "" {
+		return c.Input
+	}
+
+	return c.VariantName()
 }
 
-var solve = flag.Bool("solve", false, "Use the real problem input as input")
+// VariantName returns the name of the input variant that should be read.
+func (c Config) VariantName() string {
+	if c.Variant != "" {
+		return c.Variant
+	}
+
+	if c.Solve {
+		return ProblemVariant
+	}
 
-// Parses system flags and returns a cleanly wrapped config object
-func Parse() Config {
-	flag.Parse()
+	return SampleVariant
+}
 
-	return Config{
-		Solve: *solve,
+// IsProblem reports whether the real problem input is being read, which is
+// what decides between the sample and problem defaults of parameters.
+func (c Config) IsProblem() bool {
+	return c.VariantName() == ProblemVariant
+}
+
+// Logger returns the logger solvers narrate through, writing to standard
+// error at the configured verbosity.
+func (c Config) Logger() *slog.Logger {
+	level := slog.LevelWarn
+	switch {
+	case c.Verbosity >= 2:
+		level = slog.LevelDebug
+
+	case c.Verbosity == 1:
+		level = slog.LevelInfo
 	}
+
+	handler := slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			// runs are short enough that timestamps are noise
+			if len(groups) == 0 && attr.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+
+			return attr
+		},
+	})
+
+	return slog.New(handler)
 }
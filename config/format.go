@@ -0,0 +1,35 @@
+package config
+
+import "fmt"
+
+// Format selects how results are written to standard output.
+type Format int
+
+const (
+	FormatText Format = iota
+	FormatJSON
+)
+
+func (f Format) String() string {
+	if f == FormatJSON {
+		return "json"
+	}
+
+	return "text"
+}
+
+// Set implements flag.Value.
+func (f *Format) Set(value string) error {
+	switch value {
+	case "text", "":
+		*f = FormatText
+
+	case "json":
+		*f = FormatJSON
+
+	default:
+		return fmt.Errorf("unknown format %q, expected text or json", value)
+	}
+
+	return nil
+}
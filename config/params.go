@@ -0,0 +1,212 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ParamType is the set of types a puzzle parameter can have.
+type ParamType interface {
+	int | int64 | float64 | bool | string
+}
+
+// Param is a puzzle knob declared by a day's solver, such as how many
+// junctions to connect. The sample and problem inputs often need different
+// values, so each gets its own default.
+type Param[T ParamType] struct {
+	Name  string
+	Usage string
+
+	Sample  T
+	Problem T
+}
+
+// ParamName returns the name the parameter is set by.
+func (p Param[T]) ParamName() string {
+	return p.Name
+}
+
+// ParamUsage describes the parameter and its defaults.
+func (p Param[T]) ParamUsage() string {
+	return fmt.Sprintf("%s (sample %v, problem %v)", p.Usage, p.Sample, p.Problem)
+}
+
+// Get returns the value of the parameter for the configuration. A value set
+// by the -param flag wins over one from the params file, otherwise the
+// default for the input being solved is used.
+func (p Param[T]) Get(c Config) (T, error) {
+	text, ok := c.Params.lookup(c.DayPath(), p.Name)
+	if !ok {
+		if c.IsProblem() {
+			return p.Problem, nil
+		}
+
+		return p.Sample, nil
+	}
+
+	var value T
+	if err := parseParam(text, &value); err != nil {
+		return value, fmt.Errorf("invalid value %q for parameter %s: %w", text, p.Name, err)
+	}
+
+	return value, nil
+}
+
+// Check reports whether the configured value of the parameter is valid.
+func (p Param[T]) Check(c Config) error {
+	_, err := p.Get(c)
+
+	return err
+}
+
+func parseParam[T ParamType](text string, value *T) error {
+	var err error
+	switch value := any(value).(type) {
+	case *int:
+		*value, err = strconv.Atoi(text)
+
+	case *int64:
+		*value, err = strconv.ParseInt(text, 10, 64)
+
+	case *float64:
+		*value, err = strconv.ParseFloat(text, 64)
+
+	case *bool:
+		*value, err = strconv.ParseBool(text)
+
+	case *string:
+		*value = text
+
+	default:
+		err = fmt.Errorf("unsupported parameter type %T", value)
+	}
+
+	return err
+}
+
+// ParamDecl is the untyped view of a Param, used to list and check the
+// parameters a day declares.
+type ParamDecl interface {
+	ParamName() string
+	ParamUsage() string
+	Check(c Config) error
+}
+
+// Params holds the parameter values given on the command line and in a
+// params file. Values are kept as text until a Param parses them.
+type Params struct {
+	// flags holds "-param name=value" values, which apply to the configured
+	// day.
+	flags map[string]string
+	// file holds the values from the params file by day path, like
+	// "2025/day8", then parameter name.
+	file map[string]map[string]string
+}
+
+// Names returns the names of every parameter given a value for the day, given
+// by its path like "2025/day8".
+func (p Params) Names(day string) []string {
+	names := make(map[string]bool)
+	for name := range p.flags {
+		names[name] = true
+	}
+
+	for name := range p.file[day] {
+		names[name] = true
+	}
+
+	return slices.Sorted(maps.Keys(names))
+}
+
+func (p Params) lookup(day, name string) (string, bool) {
+	if value, ok := p.flags[name]; ok {
+		return value, true
+	}
+
+	value, ok := p.file[day][name]
+
+	return value, ok
+}
+
+// paramFlag sets parameters from "name=value", several can be given at once
+// separated by commas.
+type paramFlag struct {
+	params *Params
+}
+
+func (f paramFlag) String() string {
+	if f.params == nil {
+		return ""
+	}
+
+	pairs := make([]string, 0, len(f.params.flags))
+	for _, name := range slices.Sorted(maps.Keys(f.params.flags)) {
+		pairs = append(pairs, name+"="+f.params.flags[name])
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+func (f paramFlag) Set(text string) error {
+	if f.params.flags == nil {
+		f.params.flags = make(map[string]string)
+	}
+
+	for pair := range strings.SplitSeq(text, ",") {
+		name, value, found := strings.Cut(pair, "=")
+		if !found || name == "" {
+			return fmt.Errorf("parameter %q should look like name=value", pair)
+		}
+
+		f.params.flags[name] = value
+	}
+
+	return nil
+}
+
+// paramsFileFlag loads parameter values from a JSON file laid out like
+// {"2025/day8": {"target-junctions": 1000}}.
+type paramsFileFlag struct {
+	params *Params
+	path   string
+}
+
+func (f *paramsFileFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return f.path
+}
+
+func (f *paramsFileFlag) Set(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// numbers are kept as written so large integers are not rounded
+	var days map[string]map[string]any
+	decoder := json.NewDecoder(file)
+	decoder.UseNumber()
+	if err := decoder.Decode(&days); err != nil {
+		return fmt.Errorf("failed to parse params file %s: %w", path, err)
+	}
+
+	f.path = path
+	f.params.file = make(map[string]map[string]string, len(days))
+	for day, values := range days {
+		f.params.file[day] = make(map[string]string, len(values))
+		for name, value := range values {
+			f.params.file[day][name] = fmt.Sprint(value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package config
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+var targetParam = Param[int]{
+	Name:    "target",
+	Usage:   "How many to connect",
+	Sample:  10,
+	Problem: 1000,
+}
+
+// parseConfig binds a configuration to a fresh flag set and parses the args.
+func parseConfig(t *testing.T, args ...string) *Config {
+	t.Helper()
+
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	configuration := Bind(flags)
+	if err := flags.Parse(append([]string{"-day", "8"}, args...)); err != nil {
+		t.Fatalf("Parse(%v) error = %v", args, err)
+	}
+
+	return configuration
+}
+
+func writeParamsFile(t *testing.T, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "params.json")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestParamGet(t *testing.T) {
+	paramsFile := writeParamsFile(t, `{"2025/day8": {"target": 25}, "2025/day9": {"target": 7}}`)
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"sample default", nil, 10},
+		{"solve uses the problem default", []string{"-solve"}, 1000},
+		{"problem variant uses the problem default", []string{"-variant", "problem"}, 1000},
+		{"other variant uses the sample default", []string{"-variant", "edge-case"}, 10},
+		{"explicit input uses the sample default", []string{"-input", "some.in"}, 10},
+		{"params file", []string{"-params", paramsFile}, 25},
+		{"params file wins over the problem default", []string{"-solve", "-params", paramsFile}, 25},
+		{"flag", []string{"-param", "target=3"}, 3},
+		{"flag wins over params file", []string{"-params", paramsFile, "-param", "target=3"}, 3},
+		{"flag wins whatever the order", []string{"-param", "target=3", "-params", paramsFile}, 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := targetParam.Get(*parseConfig(t, test.args...))
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Get() = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParamGetInvalid(t *testing.T) {
+	configuration := parseConfig(t, "-param", "target=lots")
+
+	if _, err := targetParam.Get(*configuration); err == nil {
+		t.Error("Get() error = nil, want an error for a non-integer value")
+	}
+
+	if err := targetParam.Check(*configuration); err == nil {
+		t.Error("Check() error = nil, want an error for a non-integer value")
+	}
+}
+
+func TestParamTypes(t *testing.T) {
+	configuration := *parseConfig(t, "-param", "ratio=0.5,enabled=true,label=big")
+
+	ratio, err := Param[float64]{Name: "ratio"}.Get(configuration)
+	if err != nil || ratio != 0.5 {
+		t.Errorf("float Get() = %v, %v, want 0.5", ratio, err)
+	}
+
+	enabled, err := Param[bool]{Name: "enabled"}.Get(configuration)
+	if err != nil || !enabled {
+		t.Errorf("bool Get() = %v, %v, want true", enabled, err)
+	}
+
+	label, err := Param[string]{Name: "label"}.Get(configuration)
+	if err != nil || label != "big" {
+		t.Errorf("string Get() = %q, %v, want big", label, err)
+	}
+}
+
+func TestParamFlagSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    string
+		wantErr bool
+	}{
+		{"single", []string{"a=1"}, "a=1", false},
+		{"comma separated", []string{"b=2,a=1"}, "a=1,b=2", false},
+		{"repeated", []string{"a=1", "b=2"}, "a=1,b=2", false},
+		{"later wins", []string{"a=1", "a=2"}, "a=2", false},
+		{"empty value", []string{"a="}, "a=", false},
+		{"missing equals", []string{"a"}, "", true},
+		{"missing name", []string{"=1"}, "", true},
+		{"bad pair after good", []string{"a=1,b"}, "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				params Params
+				f      = paramFlag{&params}
+				err    error
+			)
+			for _, value := range test.values {
+				if err = f.Set(value); err != nil {
+					break
+				}
+			}
+
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Set() error = %v, want error %v", err, test.wantErr)
+			}
+
+			if !test.wantErr && f.String() != test.want {
+				t.Errorf("String() = %q, want %q", f.String(), test.want)
+			}
+		})
+	}
+}
+
+func TestParamsNames(t *testing.T) {
+	paramsFile := writeParamsFile(t, `{"2025/day8": {"target": 25, "other": "x"}, "2025/day9": {"ignored": 1}}`)
+	configuration := parseConfig(t, "-params", paramsFile, "-param", "flagged=1")
+
+	names := configuration.Params.Names(configuration.DayPath())
+	if want := []string{"flagged", "other", "target"}; !slices.Equal(names, want) {
+		t.Errorf("Names() = %v, want %v", names, want)
+	}
+}
+
+func TestParamsFileErrors(t *testing.T) {
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	Bind(flags)
+
+	if err := flags.Parse([]string{"-params", writeParamsFile(t, `{not json`)}); err == nil {
+		t.Error("Parse() error = nil, want an error for a malformed params file")
+	}
+
+	if err := flags.Parse([]string{"-params", filepath.Join(t.TempDir(), "missing.json")}); err == nil {
+		t.Error("Parse() error = nil, want an error for a missing params file")
+	}
+}
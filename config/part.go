@@ -0,0 +1,59 @@
+package config
+
+import "fmt"
+
+// Part selects which parts of a puzzle are solved. The zero value solves
+// both parts.
+type Part int
+
+const (
+	PartBoth Part = iota
+	Part1
+	Part2
+)
+
+// Parts returns the part numbers selected, in order.
+func (p Part) Parts() []int {
+	switch p {
+	case Part1:
+		return []int{1}
+
+	case Part2:
+		return []int{2}
+
+	default:
+		return []int{1, 2}
+	}
+}
+
+func (p Part) String() string {
+	switch p {
+	case Part1:
+		return "1"
+
+	case Part2:
+		return "2"
+
+	default:
+		return "both"
+	}
+}
+
+// Set implements flag.Value.
+func (p *Part) Set(value string) error {
+	switch value {
+	case "1":
+		*p = Part1
+
+	case "2":
+		*p = Part2
+
+	case "both", "":
+		*p = PartBoth
+
+	default:
+		return fmt.Errorf("unknown part %q, expected 1, 2 or both", value)
+	}
+
+	return nil
+}
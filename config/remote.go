@@ -0,0 +1,63 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	// DefaultBaseURL is the Advent of Code site that inputs are fetched from.
+	DefaultBaseURL = "https://adventofcode.com"
+	// DefaultUserAgent identifies this tool to the Advent of Code site as its
+	// automation guidelines ask.
+	DefaultUserAgent = "github.com/bbuck/advent-of-code-2025 by bbuck"
+)
+
+// Remote holds the settings for talking to the Advent of Code site.
+type Remote struct {
+	BaseURL     string
+	UserAgent   string
+	SessionFile string
+}
+
+// BindRemote registers the remote flags on the given flag set. The returned
+// settings are populated once the flag set has been parsed.
+func BindRemote(flags *flag.FlagSet) *Remote {
+	remote := new(Remote)
+
+	flags.StringVar(&remote.BaseURL, "base-url", envOr("AOC_BASE_URL", DefaultBaseURL), "Base URL of the Advent of Code site")
+	flags.StringVar(&remote.UserAgent, "user-agent", envOr("AOC_USER_AGENT", DefaultUserAgent), "User-Agent sent with every request")
+	flags.StringVar(&remote.SessionFile, "session-file", defaultSessionFile(), "File containing the session token, AOC_SESSION takes precedence")
+
+	return remote
+}
+
+func envOr(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+
+	return fallback
+}
+
+// envIntOr is like envOr for integers, a value that is not an integer is
+// ignored.
+func envIntOr(name string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
+
+func defaultSessionFile() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ".aoc-session"
+	}
+
+	return filepath.Join(dir, "aoc", "session")
+}
@@ -0,0 +1,97 @@
+package containers
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Waypoints constrain which paths are counted, a path must pass through every
+// required node and must not touch any forbidden one.
+type Waypoints[T comparable] struct {
+	Required  []T
+	Forbidden []T
+}
+
+type pathState[T comparable] struct {
+	node T
+	seen uint64
+}
+
+// CountPaths counts the paths from one node to another that only follow
+// edges with the relationship and satisfy the waypoints. Nodes on the way
+// from the start to the end must not form a cycle, otherwise the count would
+// be infinite and a *CycleError is returned. Cycles off those paths, or only
+// through forbidden nodes, are ignored.
+func (g *DirectedGraph[T]) CountPaths(from, to T, relationship string, waypoints Waypoints[T]) (*big.Int, error) {
+	if !g.HasNode(from) {
+		return nil, fmt.Errorf("graph does not have node %v", from)
+	}
+
+	if !g.HasNode(to) {
+		return nil, fmt.Errorf("graph does not have node %v", to)
+	}
+
+	// each required waypoint gets a bit, set once a path has passed it
+	if len(waypoints.Required) > 64 {
+		return nil, errors.New("at most 64 required waypoints are supported")
+	}
+
+	required := make(map[T]uint64, len(waypoints.Required))
+	for i, node := range waypoints.Required {
+		required[node] = 1 << i
+	}
+
+	var all uint64
+	for _, bit := range required {
+		all |= bit
+	}
+
+	// only nodes reachable from the start that can still reach the end are
+	// on a path, so only cycles among them make the count infinite
+	allowed := NewSetFromSeq(g.Nodes()).Difference(NewSetFromSlice(waypoints.Forbidden))
+	if !allowed.Has(from) || !allowed.Has(to) {
+		return new(big.Int), nil
+	}
+
+	var (
+		forward  = Reachable(Subgraph(g.Follow(relationship), allowed), from)
+		backward = Reachable(Subgraph(g.FollowIncoming(relationship), allowed), to)
+		view     = Subgraph(g.Follow(relationship), forward.Intersection(backward))
+	)
+
+	if _, err := TopologicalSort(view); err != nil {
+		return nil, err
+	}
+
+	var (
+		memo  = make(map[pathState[T]]*big.Int)
+		count func(state pathState[T]) *big.Int
+	)
+
+	count = func(state pathState[T]) *big.Int {
+		if total, exists := memo[state]; exists {
+			return total
+		}
+
+		total := new(big.Int)
+		if state.node == to {
+			if state.seen == all {
+				total.SetInt64(1)
+			}
+		} else {
+			for next := range view.Neighbors(state.node) {
+				total.Add(total, count(pathState[T]{
+					node: next,
+					seen: state.seen | required[next],
+				}))
+			}
+		}
+
+		memo[state] = total
+
+		return total
+	}
+
+	return count(pathState[T]{node: from, seen: required[from]}), nil
+}
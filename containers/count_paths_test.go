@@ -0,0 +1,130 @@
+package containers
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestCountPaths(t *testing.T) {
+	// every path from s to t goes through either a or b and then either c or d
+	g := newTestDirectedGraph(map[string][]string{
+		"s": {"a", "b"},
+		"a": {"c", "d"},
+		"b": {"c", "d"},
+		"c": {"t"},
+		"d": {"t"},
+	})
+	g.AddEdge("s", "t", "shortcut")
+
+	tests := []struct {
+		name      string
+		waypoints Waypoints[string]
+		want      int64
+	}{
+		{"no waypoints", Waypoints[string]{}, 4},
+		{"one required", Waypoints[string]{Required: []string{"a"}}, 2},
+		{"two required", Waypoints[string]{Required: []string{"a", "d"}}, 1},
+		{"required on different branches", Waypoints[string]{Required: []string{"a", "b"}}, 0},
+		{"required start and end", Waypoints[string]{Required: []string{"s", "t"}}, 4},
+		{"one forbidden", Waypoints[string]{Forbidden: []string{"c"}}, 2},
+		{"required and forbidden", Waypoints[string]{Required: []string{"b"}, Forbidden: []string{"d"}}, 1},
+		{"forbidden end", Waypoints[string]{Forbidden: []string{"t"}}, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			count, err := g.CountPaths("s", "t", "next", test.waypoints)
+			if err != nil {
+				t.Fatalf("CountPaths() error = %v", err)
+			}
+
+			if count.Cmp(big.NewInt(test.want)) != 0 {
+				t.Errorf("CountPaths() = %s, want %d", count, test.want)
+			}
+		})
+	}
+
+	count, err := g.CountPaths("s", "t", "shortcut", Waypoints[string]{})
+	if err != nil || count.Int64() != 1 {
+		t.Errorf("CountPaths() over shortcut = %v, %v, want 1", count, err)
+	}
+}
+
+func TestCountPathsOverflowsInt64(t *testing.T) {
+	// a chain of diamonds doubles the paths at each step
+	const diamonds = 70
+
+	edges := make(map[string][]string)
+	for i := range diamonds {
+		var (
+			from = fmt.Sprint("n", i)
+			to   = fmt.Sprint("n", i+1)
+		)
+
+		edges[from] = []string{from + "a", from + "b"}
+		edges[from+"a"] = []string{to}
+		edges[from+"b"] = []string{to}
+	}
+
+	count, err := newTestDirectedGraph(edges).CountPaths("n0", fmt.Sprint("n", diamonds), "next", Waypoints[string]{})
+	if err != nil {
+		t.Fatalf("CountPaths() error = %v", err)
+	}
+
+	if want := new(big.Int).Lsh(big.NewInt(1), diamonds); count.Cmp(want) != 0 {
+		t.Errorf("CountPaths() = %s, want %s", count, want)
+	}
+}
+
+func TestCountPathsCycles(t *testing.T) {
+	g := newTestDirectedGraph(map[string][]string{
+		"s": {"a"},
+		"a": {"t", "b"},
+		"b": {"a"},
+		"x": {"y"},
+		"y": {"x"},
+	})
+
+	_, err := g.CountPaths("s", "t", "next", Waypoints[string]{})
+
+	var cycle *CycleError[string]
+	if !errors.As(err, &cycle) {
+		t.Fatalf("CountPaths() error = %v, want a *CycleError", err)
+	}
+
+	if len(cycle.Nodes) != 2 {
+		t.Errorf("cycle = %v, want a and b", cycle.Nodes)
+	}
+
+	// a cycle the start cannot reach, or one behind a forbidden node, is fine
+	g.AddEdge("s", "t", "next")
+	count, err := g.CountPaths("s", "t", "next", Waypoints[string]{Forbidden: []string{"b"}})
+	if err != nil || count.Int64() != 2 {
+		t.Errorf("CountPaths() = %v, %v, want 2, nil", count, err)
+	}
+
+	// x and y loop forever but the start reaches them and they never get to
+	// out, so the count is still finite
+	g = newTestDirectedGraph(map[string][]string{
+		"svr": {"dac", "out", "x"},
+		"dac": {"fft"},
+		"fft": {"out"},
+		"x":   {"y"},
+		"y":   {"x"},
+	})
+
+	count, err = g.CountPaths("svr", "out", "next", Waypoints[string]{Required: []string{"dac", "fft"}})
+	if err != nil || count.Int64() != 1 {
+		t.Errorf("CountPaths() with a dead end cycle = %v, %v, want 1, nil", count, err)
+	}
+}
+
+func TestCountPathsMissingNode(t *testing.T) {
+	g := newTestDirectedGraph(map[string][]string{"s": {"t"}})
+
+	if _, err := g.CountPaths("s", "missing", "next", Waypoints[string]{}); err == nil {
+		t.Error("CountPaths() to a missing node error = nil, want an error")
+	}
+}
@@ -147,6 +147,67 @@ func (g *DirectedGraph[T]) Nodes() iter.Seq[T] {
 	return maps.Keys(g.graph)
 }
 
+// Neighbors yields the nodes the node has an outgoing edge to under any
+// relationship.
+func (g *DirectedGraph[T]) Neighbors(node T) iter.Seq[T] {
+	outgoing := g.graph[node].Outgoing
+
+	return func(yield func(T) bool) {
+		seen := NewSet[T]()
+		for _, nodes := range outgoing {
+			for next := range nodes {
+				if seen.Has(next) {
+					continue
+				}
+
+				seen.Add(next)
+				if !yield(next) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Follow returns a view of the graph that only walks outgoing edges with the
+// relationship.
+func (g *DirectedGraph[T]) Follow(relationship string) Traversable[T] {
+	return relationshipView[T]{
+		graph:        g,
+		direction:    EdgeDirectionOutgoing,
+		relationship: relationship,
+	}
+}
+
+// FollowIncoming returns a view of the graph that walks edges with the
+// relationship backwards, from the node they point to.
+func (g *DirectedGraph[T]) FollowIncoming(relationship string) Traversable[T] {
+	return relationshipView[T]{
+		graph:        g,
+		direction:    EdgeDirectionIncoming,
+		relationship: relationship,
+	}
+}
+
+type relationshipView[T comparable] struct {
+	graph        *DirectedGraph[T]
+	direction    EdgeDirection
+	relationship string
+}
+
+func (v relationshipView[T]) Nodes() iter.Seq[T] {
+	return v.graph.Nodes()
+}
+
+func (v relationshipView[T]) Neighbors(node T) iter.Seq[T] {
+	edges, exists := v.graph.graph[node]
+	if !exists {
+		return NewSet[T]().Iter()
+	}
+
+	return edges.getRelationshipMap(v.direction)[v.relationship].Iter()
+}
+
 func (g *DirectedGraph[T]) String() string {
 	builder := new(strings.Builder)
 
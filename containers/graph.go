@@ -58,6 +58,10 @@ func (g *Graph[T]) GetEdges(node T) (iter.Seq[T], error) {
 	return edges.Iter(), nil
 }
 
+func (g *Graph[T]) Neighbors(node T) iter.Seq[T] {
+	return g.graph[node].Iter()
+}
+
 func (g *Graph[T]) Nodes() iter.Seq[T] {
 	return maps.Keys(g.graph)
 }
@@ -17,6 +17,18 @@ func NewHeap[T any](less func(a, b T) bool) *Heap[T] {
 	}
 }
 
+// NewHeapFrom builds a heap holding the items in linear time, the heap takes
+// ownership of the slice.
+func NewHeapFrom[T any](items []T, less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{
+		items: items,
+		less:  less,
+	}
+	heap.Init(h)
+
+	return h
+}
+
 func (h *Heap[T]) Add(item T) {
 	heap.Push(h, item)
 }
@@ -41,10 +53,23 @@ func (h *Heap[T]) Peek() (T, bool) {
 	return h.items[0], true
 }
 
+// Iter yields the items in heap order, use Drain for sorted order.
 func (h *Heap[T]) Iter() iter.Seq[T] {
 	return slices.Values(h.items)
 }
 
+// Drain removes and yields the items in sorted order, stopping early leaves
+// the rest in the heap.
+func (h *Heap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for len(h.items) > 0 {
+			if !yield(heap.Pop(h).(T)) {
+				return
+			}
+		}
+	}
+}
+
 // containers/heap.Interface implementation
 
 func (h *Heap[T]) Len() int {
@@ -0,0 +1,180 @@
+package containers
+
+import (
+	"container/heap"
+	"iter"
+)
+
+// IndexedHeap is a priority queue of distinct items that tracks where each
+// item sits, so its priority can be changed or it can be removed while it is
+// queued.
+type IndexedHeap[T comparable, P any] struct {
+	entries []indexedEntry[T, P]
+	index   map[T]int
+	less    func(a, b P) bool
+}
+
+type indexedEntry[T comparable, P any] struct {
+	item     T
+	priority P
+}
+
+func NewIndexedHeap[T comparable, P any](less func(a, b P) bool) *IndexedHeap[T, P] {
+	return &IndexedHeap[T, P]{
+		index: make(map[T]int),
+		less:  less,
+	}
+}
+
+// NewIndexedHeapFrom builds a heap holding the items in linear time, priority
+// gives the starting priority of each. A repeated item keeps its last
+// priority.
+func NewIndexedHeapFrom[T comparable, P any](items []T, priority func(T) P, less func(a, b P) bool) *IndexedHeap[T, P] {
+	h := &IndexedHeap[T, P]{
+		entries: make([]indexedEntry[T, P], 0, len(items)),
+		index:   make(map[T]int, len(items)),
+		less:    less,
+	}
+
+	for _, item := range items {
+		if i, ok := h.index[item]; ok {
+			h.entries[i].priority = priority(item)
+
+			continue
+		}
+
+		h.index[item] = len(h.entries)
+		h.entries = append(h.entries, indexedEntry[T, P]{item: item, priority: priority(item)})
+	}
+
+	heap.Init(h)
+
+	return h
+}
+
+// Add queues the item with the priority, or changes its priority if it is
+// already queued.
+func (h *IndexedHeap[T, P]) Add(item T, priority P) {
+	if h.Update(item, priority) {
+		return
+	}
+
+	heap.Push(h, indexedEntry[T, P]{item: item, priority: priority})
+}
+
+// Update changes the priority of a queued item, it returns false if the item
+// is not queued.
+func (h *IndexedHeap[T, P]) Update(item T, priority P) bool {
+	i, ok := h.index[item]
+	if !ok {
+		return false
+	}
+
+	h.entries[i].priority = priority
+	heap.Fix(h, i)
+
+	return true
+}
+
+func (h *IndexedHeap[T, P]) Contains(item T) bool {
+	_, ok := h.index[item]
+
+	return ok
+}
+
+// Priority returns the priority the item is queued with.
+func (h *IndexedHeap[T, P]) Priority(item T) (P, bool) {
+	i, ok := h.index[item]
+	if !ok {
+		var zero P
+
+		return zero, false
+	}
+
+	return h.entries[i].priority, true
+}
+
+// Delete removes the item from the heap, it returns false if the item is not
+// queued.
+func (h *IndexedHeap[T, P]) Delete(item T) bool {
+	i, ok := h.index[item]
+	if !ok {
+		return false
+	}
+
+	heap.Remove(h, i)
+
+	return true
+}
+
+func (h *IndexedHeap[T, P]) Remove() (T, P, bool) {
+	if len(h.entries) == 0 {
+		var (
+			zeroItem     T
+			zeroPriority P
+		)
+
+		return zeroItem, zeroPriority, false
+	}
+
+	entry := heap.Pop(h).(indexedEntry[T, P])
+
+	return entry.item, entry.priority, true
+}
+
+func (h *IndexedHeap[T, P]) Peek() (T, P, bool) {
+	if len(h.entries) == 0 {
+		var (
+			zeroItem     T
+			zeroPriority P
+		)
+
+		return zeroItem, zeroPriority, false
+	}
+
+	return h.entries[0].item, h.entries[0].priority, true
+}
+
+// Drain removes and yields the items with their priorities in sorted order,
+// stopping early leaves the rest in the heap.
+func (h *IndexedHeap[T, P]) Drain() iter.Seq2[T, P] {
+	return func(yield func(T, P) bool) {
+		for len(h.entries) > 0 {
+			entry := heap.Pop(h).(indexedEntry[T, P])
+			if !yield(entry.item, entry.priority) {
+				return
+			}
+		}
+	}
+}
+
+// containers/heap.Interface implementation
+
+func (h *IndexedHeap[T, P]) Len() int {
+	return len(h.entries)
+}
+
+func (h *IndexedHeap[T, P]) Less(i, j int) bool {
+	return h.less(h.entries[i].priority, h.entries[j].priority)
+}
+
+func (h *IndexedHeap[T, P]) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+	h.index[h.entries[i].item] = i
+	h.index[h.entries[j].item] = j
+}
+
+func (h *IndexedHeap[T, P]) Push(entry any) {
+	e := entry.(indexedEntry[T, P])
+	h.index[e.item] = len(h.entries)
+	h.entries = append(h.entries, e)
+}
+
+func (h *IndexedHeap[T, P]) Pop() any {
+	length := len(h.entries)
+	out := h.entries[length-1]
+	h.entries = h.entries[0 : length-1]
+	delete(h.index, out.item)
+
+	return out
+}
@@ -0,0 +1,119 @@
+package containers
+
+import (
+	"slices"
+	"testing"
+)
+
+func lessInt(a, b int) bool {
+	return a < b
+}
+
+// checkIndex verifies every queued item is recorded at its position and that
+// the heap property holds.
+func checkIndex[T comparable](t *testing.T, h *IndexedHeap[T, int]) {
+	t.Helper()
+
+	if len(h.index) != len(h.entries) {
+		t.Fatalf("index has %d items, heap has %d", len(h.index), len(h.entries))
+	}
+
+	for i, entry := range h.entries {
+		if h.index[entry.item] != i {
+			t.Fatalf("index[%v] = %d, want %d", entry.item, h.index[entry.item], i)
+		}
+
+		if parent := (i - 1) / 2; i > 0 && h.less(entry.priority, h.entries[parent].priority) {
+			t.Fatalf("%v at %d is less than its parent %v", entry.item, i, h.entries[parent].item)
+		}
+	}
+}
+
+func TestIndexedHeapUpdateAndDelete(t *testing.T) {
+	h := NewIndexedHeap[string](lessInt)
+	for i, item := range []string{"a", "b", "c", "d", "e", "f"} {
+		h.Add(item, (i+1)*10)
+	}
+	checkIndex(t, h)
+
+	if !h.Update("f", 5) {
+		t.Fatal(`Update("f") = false, want true`)
+	}
+	checkIndex(t, h)
+
+	// adding a queued item changes its priority rather than queueing it twice
+	h.Add("a", 45)
+	checkIndex(t, h)
+
+	if !h.Delete("c") {
+		t.Fatal(`Delete("c") = false, want true`)
+	}
+	checkIndex(t, h)
+
+	if h.Contains("c") || h.Delete("c") || h.Update("c", 1) {
+		t.Error("c is still queued after Delete")
+	}
+
+	if priority, ok := h.Priority("a"); !ok || priority != 45 {
+		t.Errorf(`Priority("a") = %d, %v, want 45, true`, priority, ok)
+	}
+
+	if item, priority, ok := h.Peek(); !ok || item != "f" || priority != 5 {
+		t.Errorf("Peek() = %q, %d, %v, want f, 5, true", item, priority, ok)
+	}
+
+	var items []string
+	for item := range h.Drain() {
+		items = append(items, item)
+		checkIndex(t, h)
+	}
+
+	if want := []string{"f", "b", "d", "a", "e"}; !slices.Equal(items, want) {
+		t.Errorf("Drain() = %v, want %v", items, want)
+	}
+
+	if _, _, ok := h.Remove(); ok {
+		t.Error("Remove() on an empty heap = true, want false")
+	}
+}
+
+func TestNewIndexedHeapFrom(t *testing.T) {
+	priorities := map[string]int{"x": 3, "y": 1, "z": 2}
+	h := NewIndexedHeapFrom([]string{"x", "y", "z", "x"}, func(item string) int {
+		return priorities[item]
+	}, lessInt)
+	checkIndex(t, h)
+
+	if h.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", h.Len())
+	}
+
+	var items []string
+	for item := range h.Drain() {
+		items = append(items, item)
+	}
+
+	if want := []string{"y", "z", "x"}; !slices.Equal(items, want) {
+		t.Errorf("Drain() = %v, want %v", items, want)
+	}
+}
+
+func TestHeapDrainSorts(t *testing.T) {
+	h := NewHeapFrom([]int{5, 3, 9, 1, 7}, lessInt)
+
+	var items []int
+	for item := range h.Drain() {
+		items = append(items, item)
+		if len(items) == 2 {
+			break
+		}
+	}
+
+	if !slices.Equal(items, []int{1, 3}) || h.Len() != 3 {
+		t.Fatalf("partial Drain() = %v leaving %d, want [1 3] leaving 3", items, h.Len())
+	}
+
+	if rest := slices.Collect(h.Drain()); !slices.Equal(rest, []int{5, 7, 9}) {
+		t.Errorf("Drain() = %v, want [5 7 9]", rest)
+	}
+}
@@ -0,0 +1,192 @@
+package containers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CondensedRelationship is the relationship of the edges in a condensed
+// graph.
+const CondensedRelationship = "condensed"
+
+// CycleError is returned when a graph that should be acyclic has a cycle.
+// Nodes lists the cycle in edge order, the last node leads back to the first.
+type CycleError[T comparable] struct {
+	Nodes []T
+}
+
+func (e *CycleError[T]) Error() string {
+	builder := new(strings.Builder)
+	builder.WriteString("graph has a cycle: ")
+	for _, node := range e.Nodes {
+		fmt.Fprint(builder, node)
+		builder.WriteString(" -> ")
+	}
+
+	if len(e.Nodes) > 0 {
+		fmt.Fprint(builder, e.Nodes[0])
+	}
+
+	return builder.String()
+}
+
+// TopologicalSort orders the nodes so every edge goes from an earlier node to
+// a later one. It returns a *CycleError if there is no such order.
+func TopologicalSort[T comparable](g Traversable[T]) ([]T, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var (
+		state = make(map[T]int)
+		stack []T
+		order []T
+		visit func(node T) error
+	)
+
+	visit = func(node T) error {
+		switch state[node] {
+		case visited:
+			return nil
+
+		case visiting:
+			// the cycle is the part of the stack from the first visit of node
+			for i := len(stack) - 1; i >= 0; i-- {
+				if stack[i] == node {
+					return &CycleError[T]{Nodes: slices.Clone(stack[i:])}
+				}
+			}
+		}
+
+		state[node] = visiting
+		stack = append(stack, node)
+		for neighbor := range g.Neighbors(node) {
+			if err := visit(neighbor); err != nil {
+				return err
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[node] = visited
+		order = append(order, node)
+
+		return nil
+	}
+
+	for node := range g.Nodes() {
+		if err := visit(node); err != nil {
+			return nil, err
+		}
+	}
+
+	// nodes were added once everything after them was, so reverse them
+	slices.Reverse(order)
+
+	return order, nil
+}
+
+// StronglyConnectedComponents groups the nodes into sets where every node can
+// reach every other, using Tarjan's algorithm. The components are returned
+// in reverse topological order, a component only has edges to components
+// before it.
+func StronglyConnectedComponents[T comparable](g Traversable[T]) []Set[T] {
+	var (
+		index      int
+		indices    = make(map[T]int)
+		lowLinks   = make(map[T]int)
+		onStack    = NewSet[T]()
+		stack      []T
+		components []Set[T]
+		connect    func(node T)
+	)
+
+	connect = func(node T) {
+		indices[node] = index
+		lowLinks[node] = index
+		index++
+		stack = append(stack, node)
+		onStack.Add(node)
+
+		for neighbor := range g.Neighbors(node) {
+			if _, seen := indices[neighbor]; !seen {
+				connect(neighbor)
+				lowLinks[node] = min(lowLinks[node], lowLinks[neighbor])
+			} else if onStack.Has(neighbor) {
+				lowLinks[node] = min(lowLinks[node], indices[neighbor])
+			}
+		}
+
+		if lowLinks[node] != indices[node] {
+			return
+		}
+
+		component := NewSet[T]()
+		for {
+			member := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack.Remove(member)
+			component.Add(member)
+
+			if member == node {
+				break
+			}
+		}
+
+		components = append(components, component)
+	}
+
+	for node := range g.Nodes() {
+		if _, seen := indices[node]; !seen {
+			connect(node)
+		}
+	}
+
+	return components
+}
+
+// Condensation is a graph with each strongly connected component collapsed
+// into a single node, which is always acyclic.
+type Condensation[T comparable] struct {
+	// Graph has a node for each index into Components, joined by
+	// CondensedRelationship edges.
+	Graph      *DirectedGraph[int]
+	Components []Set[T]
+
+	component map[T]int
+}
+
+// Condense collapses the strongly connected components of the graph.
+func Condense[T comparable](g Traversable[T]) *Condensation[T] {
+	condensation := &Condensation[T]{
+		Graph:      NewDirectedGraph[int](),
+		Components: StronglyConnectedComponents(g),
+		component:  make(map[T]int),
+	}
+
+	for i, component := range condensation.Components {
+		condensation.Graph.AddNode(i)
+		for node := range component {
+			condensation.component[node] = i
+		}
+	}
+
+	for node, from := range condensation.component {
+		for neighbor := range g.Neighbors(node) {
+			if to := condensation.component[neighbor]; to != from {
+				condensation.Graph.AddEdge(from, to, CondensedRelationship)
+			}
+		}
+	}
+
+	return condensation
+}
+
+// ComponentOf returns the index of the component holding the node.
+func (c *Condensation[T]) ComponentOf(node T) (int, bool) {
+	index, exists := c.component[node]
+
+	return index, exists
+}
@@ -0,0 +1,146 @@
+package containers
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func hasEdge(g *DirectedGraph[string], from, to string) bool {
+	return slices.Contains(slices.Collect(g.Neighbors(from)), to)
+}
+
+func TestTopologicalSort(t *testing.T) {
+	g := newTestDirectedGraph(map[string][]string{
+		"shirt": {"tie", "belt"},
+		"tie":   {"jacket"},
+		"pants": {"shoes", "belt"},
+		"belt":  {"jacket"},
+		"socks": {"shoes"},
+	})
+
+	order, err := TopologicalSort(g)
+	if err != nil {
+		t.Fatalf("TopologicalSort() error = %v", err)
+	}
+
+	if len(order) != 7 {
+		t.Fatalf("TopologicalSort() = %v, want all 7 nodes", order)
+	}
+
+	for i, node := range order {
+		for _, later := range order[:i] {
+			if hasEdge(g, node, later) {
+				t.Errorf("%s comes after %s but has an edge to it", node, later)
+			}
+		}
+	}
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	g := newTestDirectedGraph(map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"d"},
+		"d": {"b", "e"},
+	})
+
+	_, err := TopologicalSort(g)
+
+	var cycle *CycleError[string]
+	if !errors.As(err, &cycle) {
+		t.Fatalf("TopologicalSort() error = %v, want a *CycleError", err)
+	}
+
+	if got := slices.Sorted(slices.Values(cycle.Nodes)); !slices.Equal(got, []string{"b", "c", "d"}) {
+		t.Fatalf("cycle nodes = %v, want b, c and d", cycle.Nodes)
+	}
+
+	// the nodes are in edge order, each leads to the next and the last back
+	// to the first
+	for i, node := range cycle.Nodes {
+		next := cycle.Nodes[(i+1)%len(cycle.Nodes)]
+		if !hasEdge(g, node, next) {
+			t.Errorf("cycle %v has no edge %s -> %s", cycle.Nodes, node, next)
+		}
+	}
+
+	message := err.Error()
+	if !strings.HasPrefix(message, "graph has a cycle: ") || strings.Count(message, " -> ") != 3 {
+		t.Errorf("Error() = %q, want the cycle listed back to its start", message)
+	}
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	g := newTestDirectedGraph(map[string][]string{
+		"a": {"b"},
+		"b": {"c", "e", "f"},
+		"c": {"d", "g"},
+		"d": {"c", "h"},
+		"e": {"a", "f"},
+		"f": {"g"},
+		"g": {"f"},
+		"h": {"d", "g"},
+	})
+
+	components := StronglyConnectedComponents(g)
+
+	var got []string
+	for _, component := range components {
+		got = append(got, strings.Join(slices.Sorted(component.Iter()), ""))
+	}
+
+	if want := []string{"abe", "cdh", "fg"}; !slices.Equal(slices.Sorted(slices.Values(got)), want) {
+		t.Fatalf("components = %v, want %v", got, want)
+	}
+
+	// reverse topological order, edges only lead to earlier components
+	position := make(map[string]int)
+	for i, component := range components {
+		for node := range component {
+			position[node] = i
+		}
+	}
+
+	for node := range g.Nodes() {
+		for next := range g.Neighbors(node) {
+			if position[next] > position[node] {
+				t.Errorf("edge %s -> %s leads to a later component", node, next)
+			}
+		}
+	}
+}
+
+func TestCondense(t *testing.T) {
+	g := newTestDirectedGraph(map[string][]string{
+		"a": {"b"},
+		"b": {"a", "c"},
+		"c": {"d"},
+		"d": {"c"},
+	})
+
+	condensation := Condense(g)
+	if len(condensation.Components) != 2 {
+		t.Fatalf("Condense() has %d components, want 2", len(condensation.Components))
+	}
+
+	if _, err := TopologicalSort(condensation.Graph); err != nil {
+		t.Fatalf("condensed graph is not acyclic: %v", err)
+	}
+
+	ab, _ := condensation.ComponentOf("a")
+	cd, _ := condensation.ComponentOf("d")
+	if ab == cd {
+		t.Fatal("a and d were put in the same component")
+	}
+
+	edges, _ := condensation.Graph.GetOutgoingEdges(ab, CondensedRelationship)
+	if got := slices.Collect(edges); !slices.Equal(got, []int{cd}) {
+		t.Errorf("component of a has edges to %v, want [%d]", got, cd)
+	}
+
+	if _, ok := condensation.ComponentOf("z"); ok {
+		t.Error("ComponentOf(z) = true for a node not in the graph")
+	}
+}
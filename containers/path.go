@@ -0,0 +1,116 @@
+package containers
+
+import "slices"
+
+// Number is the set of types path costs can be measured in.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Predecessors records the node each node was first reached from during a
+// search, the start node has no entry.
+type Predecessors[T comparable] map[T]T
+
+// Path walks back from to until it reaches from and returns the nodes in
+// order from first to last. It returns false if to was never reached.
+func (p Predecessors[T]) Path(from, to T) ([]T, bool) {
+	path := []T{to}
+	for node := to; node != from; {
+		previous, exists := p[node]
+		if !exists {
+			return nil, false
+		}
+
+		path = append(path, previous)
+		node = previous
+	}
+
+	slices.Reverse(path)
+
+	return path, true
+}
+
+// SearchTree runs a breadth first search from start and returns how each
+// reachable node was first reached.
+func SearchTree[T comparable](g Traversable[T], start T) Predecessors[T] {
+	var (
+		predecessors = make(Predecessors[T])
+		seen         = NewSet[T]()
+		queue        = []T{start}
+	)
+
+	seen.Add(start)
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for neighbor := range g.Neighbors(node) {
+			if seen.Has(neighbor) {
+				continue
+			}
+
+			seen.Add(neighbor)
+			predecessors[neighbor] = node
+			queue = append(queue, neighbor)
+		}
+	}
+
+	return predecessors
+}
+
+// ShortestPath returns a path from one node to another using the fewest
+// edges, or false if there is none.
+func ShortestPath[T comparable](g Traversable[T], from, to T) ([]T, bool) {
+	return SearchTree(g, from).Path(from, to)
+}
+
+// Dijkstra returns the cheapest path from one node to another and its cost,
+// where cost gives the non-negative cost of each edge. It returns false if
+// there is no path.
+func Dijkstra[T comparable, W Number](g Traversable[T], from, to T, cost func(from, to T) W) ([]T, W, bool) {
+	return AStar(g, from, to, cost, func(T) W {
+		return 0
+	})
+}
+
+// AStar returns the cheapest path from one node to another and its cost like
+// Dijkstra, using heuristic to estimate the remaining cost from a node to the
+// goal. The heuristic must never overestimate for the path to be the
+// cheapest, nodes are searched again whenever a cheaper way to them is found
+// so it need not be consistent.
+func AStar[T comparable, W Number](g Traversable[T], from, to T, cost func(from, to T) W, heuristic func(node T) W) ([]T, W, bool) {
+	var (
+		predecessors = make(Predecessors[T])
+		costs        = map[T]W{from: 0}
+		open         = NewIndexedHeap[T](func(a, b W) bool {
+			return a < b
+		})
+	)
+
+	open.Add(from, heuristic(from))
+	for open.Len() > 0 {
+		node, _, _ := open.Remove()
+		if node == to {
+			path, _ := predecessors.Path(from, to)
+
+			return path, costs[to], true
+		}
+
+		for neighbor := range g.Neighbors(node) {
+			next := costs[node] + cost(node, neighbor)
+			if known, exists := costs[neighbor]; exists && known <= next {
+				continue
+			}
+
+			costs[neighbor] = next
+			predecessors[neighbor] = node
+			open.Add(neighbor, next+heuristic(neighbor))
+		}
+	}
+
+	var zero W
+
+	return nil, zero, false
+}
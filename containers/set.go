@@ -13,6 +13,24 @@ func NewSet[T comparable]() Set[T] {
 	return Set[T](m)
 }
 
+func NewSetFromSlice[T comparable](items []T) Set[T] {
+	s := make(Set[T], len(items))
+	for _, item := range items {
+		s.Add(item)
+	}
+
+	return s
+}
+
+func NewSetFromSeq[T comparable](items iter.Seq[T]) Set[T] {
+	s := NewSet[T]()
+	for item := range items {
+		s.Add(item)
+	}
+
+	return s
+}
+
 func (s Set[T]) Add(item T) {
 	s[item] = struct{}{}
 }
@@ -34,3 +52,85 @@ func (s Set[T]) Clone() Set[T] {
 func (s Set[T]) Iter() iter.Seq[T] {
 	return maps.Keys(s)
 }
+
+// Len returns the number of items in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Union returns a new set holding the items of both sets.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	union := make(Set[T], len(s)+len(other))
+	for item := range s {
+		union.Add(item)
+	}
+
+	for item := range other {
+		union.Add(item)
+	}
+
+	return union
+}
+
+// Intersection returns a new set holding the items in both sets.
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	// walk the smaller set, every item must be in both anyway
+	small, large := s, other
+	if len(large) < len(small) {
+		small, large = large, small
+	}
+
+	intersection := NewSet[T]()
+	for item := range small {
+		if large.Has(item) {
+			intersection.Add(item)
+		}
+	}
+
+	return intersection
+}
+
+// Difference returns a new set holding the items of s that are not in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	difference := NewSet[T]()
+	for item := range s {
+		if !other.Has(item) {
+			difference.Add(item)
+		}
+	}
+
+	return difference
+}
+
+// SymmetricDifference returns a new set holding the items that are in exactly
+// one of the sets.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	difference := s.Difference(other)
+	for item := range other {
+		if !s.Has(item) {
+			difference.Add(item)
+		}
+	}
+
+	return difference
+}
+
+// IsSubsetOf reports whether every item of s is also in other.
+func (s Set[T]) IsSubsetOf(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+
+	for item := range s {
+		if !other.Has(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal reports whether both sets hold exactly the same items.
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubsetOf(other)
+}
@@ -0,0 +1,101 @@
+package containers
+
+import (
+	"slices"
+	"testing"
+)
+
+func sortedItems(s Set[int]) []int {
+	return slices.Sorted(s.Iter())
+}
+
+func TestSetAlgebra(t *testing.T) {
+	var (
+		a     = NewSetFromSlice([]int{1, 2, 3, 4})
+		b     = NewSetFromSlice([]int{3, 4, 5})
+		empty = NewSet[int]()
+		none  Set[int]
+	)
+
+	tests := []struct {
+		name string
+		got  Set[int]
+		want []int
+	}{
+		{"union", a.Union(b), []int{1, 2, 3, 4, 5}},
+		{"intersection", a.Intersection(b), []int{3, 4}},
+		{"difference", a.Difference(b), []int{1, 2}},
+		{"reverse difference", b.Difference(a), []int{5}},
+		{"symmetric difference", a.SymmetricDifference(b), []int{1, 2, 5}},
+		{"union with empty", a.Union(empty), []int{1, 2, 3, 4}},
+		{"intersection with empty", a.Intersection(empty), nil},
+		{"difference from empty", empty.Difference(a), nil},
+		{"difference of empty", a.Difference(empty), []int{1, 2, 3, 4}},
+		{"symmetric difference with empty", empty.SymmetricDifference(b), []int{3, 4, 5}},
+		{"symmetric difference with itself", a.SymmetricDifference(a), nil},
+		{"union of nil", none.Union(b), []int{3, 4, 5}},
+		{"union with nil", a.Union(none), []int{1, 2, 3, 4}},
+		{"intersection of nil", none.Intersection(a), nil},
+		{"difference of nil", none.Difference(a), nil},
+		{"symmetric difference of nil", none.SymmetricDifference(b), []int{3, 4, 5}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := sortedItems(test.got); !slices.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+
+	// none of the operations change the sets they were called on
+	if got := sortedItems(a); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("a was changed to %v", got)
+	}
+}
+
+func TestSetComparisons(t *testing.T) {
+	var (
+		small = NewSetFromSlice([]int{1, 2})
+		large = NewSetFromSlice([]int{1, 2, 3})
+		other = NewSetFromSlice([]int{1, 4})
+		empty = NewSet[int]()
+	)
+
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"subset", small.IsSubsetOf(large), true},
+		{"superset is not a subset", large.IsSubsetOf(small), false},
+		{"overlapping is not a subset", other.IsSubsetOf(large), false},
+		{"empty is a subset", empty.IsSubsetOf(small), true},
+		{"subset of itself", small.IsSubsetOf(small), true},
+		{"equal to a copy", small.Equal(NewSetFromSlice([]int{2, 1, 2})), true},
+		{"different sizes are not equal", small.Equal(large), false},
+		{"larger is not equal to subset", large.Equal(small), false},
+		{"same size different items", small.Equal(other), false},
+		{"empty sets are equal", empty.Equal(NewSet[int]()), true},
+		{"empty is not equal to non-empty", empty.Equal(small), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.got != test.want {
+				t.Errorf("got %v, want %v", test.got, test.want)
+			}
+		})
+	}
+}
+
+func TestNewSetFromSeq(t *testing.T) {
+	s := NewSetFromSeq(slices.Values([]int{3, 1, 3, 2}))
+	if s.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", s.Len())
+	}
+
+	if got := sortedItems(s); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("items = %v, want [1 2 3]", got)
+	}
+}
@@ -0,0 +1,202 @@
+package containers
+
+import (
+	"iter"
+	"slices"
+)
+
+// Traversable is a graph that searches can walk, Neighbors yields the nodes
+// one step away from a node and nothing for a node not in the graph.
+type Traversable[T comparable] interface {
+	Nodes() iter.Seq[T]
+	Neighbors(node T) iter.Seq[T]
+}
+
+// BFS yields the nodes reachable from start in breadth first order along with
+// how many steps away from start each is.
+func BFS[T comparable](g Traversable[T], start T) iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		var (
+			seen  = NewSet[T]()
+			queue = []T{start}
+			depth = map[T]int{start: 0}
+		)
+
+		seen.Add(start)
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+
+			if !yield(node, depth[node]) {
+				return
+			}
+
+			for neighbor := range g.Neighbors(node) {
+				if seen.Has(neighbor) {
+					continue
+				}
+
+				seen.Add(neighbor)
+				depth[neighbor] = depth[node] + 1
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+}
+
+// DFS yields the nodes reachable from start in depth first order.
+func DFS[T comparable](g Traversable[T], start T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var (
+			seen  = NewSet[T]()
+			stack = []T{start}
+		)
+
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if seen.Has(node) {
+				continue
+			}
+
+			seen.Add(node)
+			if !yield(node) {
+				return
+			}
+
+			for neighbor := range g.Neighbors(node) {
+				if !seen.Has(neighbor) {
+					stack = append(stack, neighbor)
+				}
+			}
+		}
+	}
+}
+
+// Reachable returns every node that can be reached from start, including
+// start itself.
+func Reachable[T comparable](g Traversable[T], start T) Set[T] {
+	reachable := NewSet[T]()
+	for node := range BFS(g, start) {
+		reachable.Add(node)
+	}
+
+	return reachable
+}
+
+// Subgraph returns a view of the graph that only holds the given nodes and
+// the edges between them.
+func Subgraph[T comparable](g Traversable[T], nodes Set[T]) Traversable[T] {
+	return subgraphView[T]{
+		graph: g,
+		nodes: nodes,
+	}
+}
+
+type subgraphView[T comparable] struct {
+	graph Traversable[T]
+	nodes Set[T]
+}
+
+func (v subgraphView[T]) Nodes() iter.Seq[T] {
+	return v.nodes.Iter()
+}
+
+func (v subgraphView[T]) Neighbors(node T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if !v.nodes.Has(node) {
+			return
+		}
+
+		for neighbor := range v.graph.Neighbors(node) {
+			if v.nodes.Has(neighbor) && !yield(neighbor) {
+				return
+			}
+		}
+	}
+}
+
+// ConnectedComponents groups the nodes of the graph into the sets joined by
+// edges. Edge direction is ignored, so a DirectedGraph gives its weakly
+// connected components.
+func ConnectedComponents[T comparable](g Traversable[T]) []Set[T] {
+	forest := NewDisjointSetForest[T]()
+	for node := range g.Nodes() {
+		forest.NewSet(node)
+	}
+
+	for node := range g.Nodes() {
+		for neighbor := range g.Neighbors(node) {
+			forest.NewSet(neighbor)
+			forest.Union(node, neighbor)
+		}
+	}
+
+	var (
+		components = make([]Set[T], 0, forest.SetCount())
+		byRoot     = make(map[T]int, forest.SetCount())
+	)
+	for node := range forest.forest {
+		root := forest.Find(node)
+		index, exists := byRoot[root]
+		if !exists {
+			index = len(components)
+			byRoot[root] = index
+			components = append(components, NewSet[T]())
+		}
+
+		components[index].Add(node)
+	}
+
+	return components
+}
+
+// AllPaths yields every path from one node to another that visits no node
+// twice. Each path is a new slice the caller may keep. Unless it is nil,
+// visit is called with every node the search steps onto, if it returns an
+// error the search stops and yields the error.
+func AllPaths[T comparable](g Traversable[T], from, to T, visit func(node T) error) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		var (
+			path   = []T{from}
+			onPath = NewSet[T]()
+			walk   func(node T) bool
+		)
+
+		onPath.Add(from)
+		walk = func(node T) bool {
+			if visit != nil {
+				if err := visit(node); err != nil {
+					yield(nil, err)
+
+					return false
+				}
+			}
+
+			if node == to {
+				return yield(slices.Clone(path), nil)
+			}
+
+			for neighbor := range g.Neighbors(node) {
+				if onPath.Has(neighbor) {
+					continue
+				}
+
+				path = append(path, neighbor)
+				onPath.Add(neighbor)
+				more := walk(neighbor)
+				onPath.Remove(neighbor)
+				path = path[:len(path)-1]
+
+				if !more {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		walk(from)
+	}
+}
@@ -0,0 +1,255 @@
+package containers
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// newTestGraph builds an undirected graph:
+//
+//	0 - 1 - 2 - 3
+//	 \_________/
+//	4 - 5   6
+func newTestGraph() *Graph[int] {
+	g := NewGraph[int]()
+	for node := range 7 {
+		g.AddNode(node)
+	}
+
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(0, 3)
+	g.AddEdge(4, 5)
+
+	return g
+}
+
+func newTestDirectedGraph(edges map[string][]string) *DirectedGraph[string] {
+	g := NewDirectedGraph[string]()
+	for from, tos := range edges {
+		g.AddNode(from)
+		for _, to := range tos {
+			g.AddNode(to)
+			g.AddEdge(from, to, "next")
+		}
+	}
+
+	return g
+}
+
+func TestBFSDepths(t *testing.T) {
+	depths := maps.Collect(BFS(newTestGraph(), 0))
+
+	want := map[int]int{0: 0, 1: 1, 3: 1, 2: 2}
+	if !maps.Equal(depths, want) {
+		t.Errorf("BFS() depths = %v, want %v", depths, want)
+	}
+}
+
+func TestDFSVisitsReachableOnce(t *testing.T) {
+	g := newTestDirectedGraph(map[string][]string{
+		"a": {"b", "c"},
+		"b": {"d"},
+		"c": {"d"},
+		"e": {"a"},
+	})
+
+	nodes := slices.Collect(DFS(g, "a"))
+	if nodes[0] != "a" {
+		t.Errorf("DFS() starts at %q, want a", nodes[0])
+	}
+
+	slices.Sort(nodes)
+	if want := []string{"a", "b", "c", "d"}; !slices.Equal(nodes, want) {
+		t.Errorf("DFS() = %v, want %v", nodes, want)
+	}
+}
+
+func TestConnectedComponents(t *testing.T) {
+	var sizes []int
+	for _, component := range ConnectedComponents(newTestGraph()) {
+		sizes = append(sizes, component.Len())
+	}
+
+	slices.Sort(sizes)
+	if want := []int{1, 2, 4}; !slices.Equal(sizes, want) {
+		t.Errorf("component sizes = %v, want %v", sizes, want)
+	}
+}
+
+func TestShortestPath(t *testing.T) {
+	g := newTestGraph()
+
+	path, ok := ShortestPath(g, 1, 3)
+	if !ok || len(path) != 3 || path[0] != 1 || path[2] != 3 {
+		t.Errorf("ShortestPath(1, 3) = %v, %v, want a 3 node path", path, ok)
+	}
+
+	if path, ok := ShortestPath(g, 0, 0); !ok || !slices.Equal(path, []int{0}) {
+		t.Errorf("ShortestPath(0, 0) = %v, %v, want [0], true", path, ok)
+	}
+
+	if path, ok := ShortestPath(g, 0, 5); ok {
+		t.Errorf("ShortestPath(0, 5) = %v, true, want no path", path)
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	g := newTestDirectedGraph(map[string][]string{
+		"s": {"a", "b"},
+		"a": {"g"},
+		"b": {"c"},
+		"c": {"g"},
+	})
+	costs := map[[2]string]int{
+		{"s", "a"}: 1, {"a", "g"}: 10,
+		{"s", "b"}: 2, {"b", "c"}: 2, {"c", "g"}: 2,
+	}
+	cost := func(from, to string) int {
+		return costs[[2]string{from, to}]
+	}
+
+	path, total, ok := Dijkstra(g, "s", "g", cost)
+	if !ok || total != 6 || !slices.Equal(path, []string{"s", "b", "c", "g"}) {
+		t.Errorf("Dijkstra() = %v, %d, %v, want [s b c g], 6, true", path, total, ok)
+	}
+
+	if _, _, ok := Dijkstra(g, "g", "s", cost); ok {
+		t.Error("Dijkstra() found a path against the edges")
+	}
+}
+
+func TestAStarReopensNodes(t *testing.T) {
+	g := newTestDirectedGraph(map[string][]string{
+		"s": {"a", "b"},
+		"a": {"b"},
+		"b": {"g"},
+	})
+	costs := map[[2]string]int{
+		{"s", "a"}: 1, {"s", "b"}: 4, {"a", "b"}: 1, {"b", "g"}: 5,
+	}
+	cost := func(from, to string) int {
+		return costs[[2]string{from, to}]
+	}
+
+	// admissible but not consistent, b is first reached the expensive way
+	heuristic := func(node string) int {
+		if node == "a" {
+			return 5
+		}
+
+		return 0
+	}
+
+	path, total, ok := AStar(g, "s", "g", cost, heuristic)
+	if !ok || total != 7 || !slices.Equal(path, []string{"s", "a", "b", "g"}) {
+		t.Errorf("AStar() = %v, %d, %v, want [s a b g], 7, true", path, total, ok)
+	}
+}
+
+func TestAllPaths(t *testing.T) {
+	g := newTestDirectedGraph(map[string][]string{
+		"a": {"b", "c"},
+		"b": {"d", "a"},
+		"c": {"d"},
+	})
+
+	var paths []string
+	for path, err := range AllPaths(g, "a", "d", nil) {
+		if err != nil {
+			t.Fatalf("AllPaths() error = %v", err)
+		}
+
+		paths = append(paths, strings.Join(path, ""))
+	}
+
+	slices.Sort(paths)
+	if want := []string{"abd", "acd"}; !slices.Equal(paths, want) {
+		t.Errorf("AllPaths() = %v, want %v", paths, want)
+	}
+}
+
+func TestAllPathsStopsOnVisitError(t *testing.T) {
+	var (
+		g = newTestDirectedGraph(map[string][]string{
+			"a": {"b"},
+			"b": {"c"},
+			"c": {"d"},
+		})
+		stop    = errors.New("stop")
+		visited []string
+	)
+
+	visit := func(node string) error {
+		visited = append(visited, node)
+		if node == "c" {
+			return stop
+		}
+
+		return nil
+	}
+
+	var errs []error
+	for path, err := range AllPaths(g, "a", "d", visit) {
+		if err == nil {
+			t.Errorf("AllPaths() yielded %v past the error", path)
+		}
+
+		errs = append(errs, err)
+	}
+
+	if len(errs) != 1 || !errors.Is(errs[0], stop) {
+		t.Errorf("AllPaths() errors = %v, want just %v", errs, stop)
+	}
+
+	if want := []string{"a", "b", "c"}; !slices.Equal(visited, want) {
+		t.Errorf("visited = %v, want %v", visited, want)
+	}
+}
+
+func TestFollowOnlyWalksRelationship(t *testing.T) {
+	g := newTestDirectedGraph(map[string][]string{"a": {"b"}})
+	g.AddNode("c")
+	g.AddEdge("a", "c", "other")
+
+	if got := slices.Sorted(g.Neighbors("a")); !slices.Equal(got, []string{"b", "c"}) {
+		t.Errorf("Neighbors(a) = %v, want [b c]", got)
+	}
+
+	if got := slices.Collect(g.Follow("other").Neighbors("a")); !slices.Equal(got, []string{"c"}) {
+		t.Errorf("Follow(other).Neighbors(a) = %v, want [c]", got)
+	}
+}
+
+func TestReachableAndSubgraph(t *testing.T) {
+	g := newTestDirectedGraph(map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"d": {"b"},
+	})
+
+	if got := slices.Sorted(Reachable(g, "b").Iter()); !slices.Equal(got, []string{"b", "c"}) {
+		t.Errorf("Reachable(b) = %v, want [b c]", got)
+	}
+
+	if got := slices.Sorted(Reachable(g.FollowIncoming("next"), "b").Iter()); !slices.Equal(got, []string{"a", "b", "d"}) {
+		t.Errorf("Reachable(b) backwards = %v, want [a b d]", got)
+	}
+
+	sub := Subgraph[string](g, NewSetFromSlice([]string{"a", "b", "d"}))
+	if got := slices.Sorted(sub.Nodes()); !slices.Equal(got, []string{"a", "b", "d"}) {
+		t.Errorf("Subgraph nodes = %v, want [a b d]", got)
+	}
+
+	if got := slices.Collect(sub.Neighbors("b")); len(got) != 0 {
+		t.Errorf("Subgraph Neighbors(b) = %v, want none since c is left out", got)
+	}
+
+	if got := slices.Collect(sub.Neighbors("c")); len(got) != 0 {
+		t.Errorf("Subgraph Neighbors(c) = %v, want none for a node outside it", got)
+	}
+}
@@ -0,0 +1,196 @@
+package day1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/solver"
+)
+
+var (
+	// dialStart is the number the dial points at before the first rotation.
+	dialStart = config.Param[int64]{
+		Name:    "dial-start",
+		Usage:   "Number the dial points at before any rotation",
+		Sample:  50,
+		Problem: 50,
+	}
+	// dialSize is how many numbers are around the dial, starting at 0.
+	dialSize = config.Param[int64]{
+		Name:    "dial-size",
+		Usage:   "Number of positions around the dial",
+		Sample:  100,
+		Problem: 100,
+	}
+)
+
+func init() {
+	solver.Register(2025, 1, New, dialStart, dialSize)
+}
+
+type Rotation struct {
+	Line  string
+	Left  bool
+	Count int64
+}
+
+type Solver struct {
+	configuration config.Config
+	logger        *slog.Logger
+	rotations     []Rotation
+}
+
+func New(configuration config.Config) solver.Solver {
+	return &Solver{
+		configuration: configuration,
+		logger:        configuration.Logger(),
+	}
+}
+
+func (s *Solver) Parse(lines []string) error {
+	for i, line := range lines {
+		if line == "" || (line[0] != 'L' && line[0] != 'R') {
+			return input.ColumnError(i, 1, line, errors.New("rotation must start with L or R"))
+		}
+
+		countStr := line[1:]
+		count, err := strconv.ParseInt(countStr, 10, 64)
+		if err != nil {
+			return input.ColumnError(i, 2, line, fmt.Errorf("failed to convert the number %q", countStr))
+		}
+
+		s.rotations = append(s.rotations, Rotation{
+			Line:  line,
+			Left:  line[0] == 'L',
+			Count: count,
+		})
+	}
+
+	return nil
+}
+
+func (s *Solver) SolvePart1(_ context.Context) (any, error) {
+	dial, err := s.newDial()
+	if err != nil {
+		return nil, err
+	}
+
+	var password int64
+
+	for _, rotation := range s.rotations {
+		if rotation.Left {
+			dial.rotateLeft(rotation.Count)
+		} else {
+			dial.rotateRight(rotation.Count)
+		}
+
+		if dial.value == 0 {
+			password++
+		}
+	}
+
+	return password, nil
+}
+
+func (s *Solver) SolvePart2(_ context.Context) (any, error) {
+	dial, err := s.newDial()
+	if err != nil {
+		return nil, err
+	}
+
+	var password int64
+
+	s.logger.Info("the dial starts", "pointing_at", dial.value)
+	for _, rotation := range s.rotations {
+		var sawZero int64
+		if rotation.Left {
+			sawZero = dial.rotateLeft(rotation.Count)
+		} else {
+			sawZero = dial.rotateRight(rotation.Count)
+		}
+
+		s.logger.Info("the dial is rotated", "rotation", rotation.Line, "pointing_at", dial.value, "passed_zero", sawZero)
+
+		password += sawZero
+
+		if dial.value == 0 {
+			password++
+		}
+	}
+
+	return password, nil
+}
+
+func abs(i int64) int64 {
+	if i < 0 {
+		return -i
+	}
+
+	return i
+}
+
+type Dial struct {
+	value int64
+	size  int64
+}
+
+// newDial creates the dial described by the configured parameters.
+func (s *Solver) newDial() (*Dial, error) {
+	start, err := dialStart.Get(s.configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := dialSize.Get(s.configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if size < 1 || start < 0 || start >= size {
+		return nil, fmt.Errorf("a dial of %d positions cannot start at %d", size, start)
+	}
+
+	return &Dial{
+		value: start,
+		size:  size,
+	}, nil
+}
+
+func (d *Dial) rotateLeft(count int64) int64 {
+	if d.value == 0 && count != 0 {
+		d.value = d.size
+	}
+
+	return d.rotate(-count)
+}
+
+func (d *Dial) rotateRight(count int64) int64 {
+	return d.rotate(count)
+}
+
+func (d *Dial) rotate(count int64) int64 {
+	sawZero := abs(count / d.size)
+	count = count % d.size
+	newValue := d.value + count
+	wrapped := newValue < 0 || newValue >= d.size
+	if newValue < 0 {
+		newValue += d.size
+	} else if newValue >= d.size {
+		newValue -= d.size
+	}
+	d.value = newValue
+	if newValue != 0 && wrapped {
+		sawZero += 1
+	}
+
+	if sawZero < 0 {
+		return 0
+	}
+
+	return sawZero
+}
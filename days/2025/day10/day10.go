@@ -1,82 +1,116 @@
-package main
+package day10
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"iter"
+	"log/slog"
 	"math"
 	"slices"
-	"strconv"
 	"strings"
-	"sync"
 
 	"bbuck.dev/aoc2025/config"
 	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/pool"
+	"bbuck.dev/aoc2025/progress"
+	"bbuck.dev/aoc2025/solver"
 )
 
-func main() {
-	configuration := config.Parse()
-	lines, err := input.ReadInput(configuration, "day10")
-	if err != nil {
-		panic(err)
+// maxPresses bounds how many times a single button is pressed while searching
+// for the free variables of a machine's joltage equations.
+var maxPresses = config.Param[int]{
+	Name:    "max-presses",
+	Usage:   "Most presses of a single button tried for part 2",
+	Sample:  266,
+	Problem: 266,
+}
+
+func init() {
+	solver.Register(2025, 10, New, maxPresses)
+}
+
+type Solver struct {
+	configuration config.Config
+	logger        *slog.Logger
+	machines      []Machine
+}
+
+func New(configuration config.Config) solver.Solver {
+	return &Solver{
+		configuration: configuration,
+		logger:        configuration.Logger(),
 	}
+}
 
-	var machines []Machine
-	for _, line := range lines {
-		machine := ParseMachine(line)
+func (s *Solver) Parse(lines []string) error {
+	for i, line := range lines {
+		machine, err := ParseMachine(line)
+		if err != nil {
+			return input.LineError(i, line, err)
+		}
 
-		machines = append(machines, machine)
+		s.machines = append(s.machines, machine)
 	}
 
-	SolvePart2(machines)
+	return nil
 }
 
-func SolvePart1(machines []Machine) {
-	var (
-		solutionLengths = make(chan int, len(machines))
-		wg              sync.WaitGroup
-	)
-
-	for _, machine := range machines {
-		wg.Go(func() {
-			solution := FindSolution(machine)
+func (s *Solver) SolvePart1(ctx context.Context) (any, error) {
+	return SolvePart1(ctx, s.machines)
+}
 
-			solutionLengths <- len(solution)
-		})
+func (s *Solver) SolvePart2(ctx context.Context) (any, error) {
+	presses, err := maxPresses.Get(s.configuration)
+	if err != nil {
+		return nil, err
 	}
 
-	go func() {
-		wg.Wait()
-		close(solutionLengths)
-	}()
+	return SolvePart2(ctx, s.logger, presses, s.machines)
+}
+
+func SolvePart1(ctx context.Context, machines []Machine) (int, error) {
+	tracker := progress.Start(ctx, "machines", len(machines))
+	defer tracker.Finish()
+
+	solutionLengths, err := pool.Map(0, machines, func(machine Machine) (int, error) {
+		solution, err := FindSolution(ctx, machine)
+		tracker.Increment()
 
-	var result int
-	for value := range solutionLengths {
-		result += value
+		return len(solution), err
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	fmt.Println(result)
+	return sumInts(solutionLengths), nil
 }
 
-func SolvePart2(machines []Machine) {
+func SolvePart2(ctx context.Context, logger *slog.Logger, maxPresses int, machines []Machine) (int, error) {
+	tracker := progress.Start(ctx, "machines", len(machines))
+	defer tracker.Finish()
+
 	var sum int
 	for i, machine := range machines {
 		matrix := machine.Matrix()
 		matrix.RowEchelonForm()
 
-		presses, solved := matrix.Solve()
+		presses, solved, err := matrix.Solve(ctx, maxPresses)
+		if err != nil {
+			return 0, err
+		}
 
 		if !solved {
-			fmt.Println(i)
-			fmt.Println(machine.Matrix())
-			fmt.Println(matrix)
+			logger.Debug("machine has no solution", "machine", i, "matrix", machine.Matrix(), "echelon_form", matrix)
 
-			return
+			return 0, fmt.Errorf("machine %d has no solution", i)
 		}
 
 		sum += sumInts(presses)
+		tracker.Increment()
 	}
 
-	fmt.Println(sum)
+	return sum, nil
 }
 
 func SpeedometerIter(dials, maxValue int) iter.Seq[[]int] {
@@ -162,11 +196,11 @@ func (r Row) Sum() float64 {
 	return r[len(r)-1]
 }
 
-func (r Row) Check(variables []int) bool {
+func (r Row) Check(variables []int, maxPresses int) bool {
 	sum := r.Sum()
 	var total float64
 	for i := range len(r) - 1 {
-		if variables[i] < 0 || variables[i] > topOut {
+		if variables[i] < 0 || variables[i] > maxPresses {
 			return false
 		}
 
@@ -246,10 +280,12 @@ func (m Matrix) RowEchelonForm() {
 	}
 }
 
-func (m Matrix) Solve() ([]int, bool) {
+// Solve finds the fewest presses of each button that satisfy the matrix,
+// trying at most maxPresses presses of any one button.
+func (m Matrix) Solve(ctx context.Context, maxPresses int) ([]int, bool, error) {
 	variables := make([]int, len(m[0])-1)
 
-	return m.solveUp(len(m)-1, len(variables), variables)
+	return m.solveUp(ctx, maxPresses, len(m)-1, len(variables), variables)
 }
 
 type Solution struct {
@@ -257,11 +293,9 @@ type Solution struct {
 	presses   int
 }
 
-const topOut = 266
-
-func (m Matrix) solveUp(row, solvedVariables int, variables []int) ([]int, bool) {
+func (m Matrix) solveUp(ctx context.Context, maxPresses, row, solvedVariables int, variables []int) ([]int, bool, error) {
 	if row < 0 {
-		return variables, true
+		return variables, true, nil
 	}
 
 	var solutions []Solution
@@ -282,20 +316,29 @@ func (m Matrix) solveUp(row, solvedVariables int, variables []int) ([]int, bool)
 		if len(freeVariables) == 0 {
 			trialVariables[pivotCol] = int(math.Round(m[row].SolvePivotColumn(pivotCol, trialVariables)))
 
-			if m[row].Check(trialVariables) {
-				return m.solveUp(row-1, pivotCol, trialVariables)
+			if m[row].Check(trialVariables, maxPresses) {
+				return m.solveUp(ctx, maxPresses, row-1, pivotCol, trialVariables)
 			}
 
-			return variables, false
+			return variables, false, nil
 		} else {
-			for guessValues := range SpeedometerIter(len(freeVariables), topOut) {
+			for guessValues := range SpeedometerIter(len(freeVariables), maxPresses) {
+				if err := ctx.Err(); err != nil {
+					return variables, false, err
+				}
+
 				for i, fv := range freeVariables {
 					trialVariables[fv] = guessValues[i]
 				}
 				trialVariables[pivotCol] = int(math.Round(m[row].SolvePivotColumn(pivotCol, trialVariables)))
 
-				if m[row].Check(trialVariables) {
-					if finalVars, solved := m.solveUp(row-1, pivotCol, trialVariables); solved {
+				if m[row].Check(trialVariables, maxPresses) {
+					finalVars, solved, err := m.solveUp(ctx, maxPresses, row-1, pivotCol, trialVariables)
+					if err != nil {
+						return variables, false, err
+					}
+
+					if solved {
 						solution := Solution{
 							variables: finalVars,
 							presses:   sumInts(finalVars),
@@ -307,11 +350,11 @@ func (m Matrix) solveUp(row, solvedVariables int, variables []int) ([]int, bool)
 		}
 	}
 
-	if pivotCol < 0 && m[row].Check(variables) {
+	if pivotCol < 0 && m[row].Check(variables, maxPresses) {
 		trialVariables := make([]int, len(variables))
 		copy(trialVariables, variables)
 
-		return m.solveUp(row-1, solvedVariables, trialVariables)
+		return m.solveUp(ctx, maxPresses, row-1, solvedVariables, trialVariables)
 	}
 
 	if len(solutions) > 0 {
@@ -322,10 +365,10 @@ func (m Matrix) solveUp(row, solvedVariables int, variables []int) ([]int, bool)
 			}
 		}
 
-		return best.variables, m[row].Check(best.variables)
+		return best.variables, m[row].Check(best.variables, maxPresses), nil
 	}
 
-	return variables, false
+	return variables, false, nil
 }
 
 func sumInts(ints []int) int {
@@ -337,15 +380,19 @@ func sumInts(ints []int) int {
 	return sum
 }
 
-func FindSolution(m Machine) []int {
+func FindSolution(ctx context.Context, m Machine) ([]int, error) {
 	root := NewStep(m, -1, nil)
 
 	solved, _ := root.RunStep()
 	for solved == nil {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		solved, _ = root.RunStep()
 	}
 
-	return solved.Solution()
+	return solved.Solution(), nil
 }
 
 type Step struct {
@@ -448,47 +495,75 @@ type Machine struct {
 	JoltageTarget   []int
 }
 
-func ParseMachine(input string) Machine {
-	parts := strings.Split(input, " ")
+func ParseMachine(line string) (Machine, error) {
+	var (
+		offsets []int
+		parts   []string
+	)
+	for offset, part := range input.Fields(line, " ") {
+		offsets = append(offsets, offset)
+		parts = append(parts, part)
+	}
+
+	if len(parts) < 3 {
+		return Machine{}, errors.New("expected indicators, buttons and joltages")
+	}
 
-	indicatorInput := parts[0]
-	joltageInput := parts[len(parts)-1]
-	buttonInputs := parts[1 : len(parts)-1]
+	last := len(parts) - 1
+	indicatorInput, err := unwrapField(offsets[0], parts[0], '[', ']')
+	if err != nil {
+		return Machine{}, err
+	}
 
 	var indicator int
-	for i, light := range indicatorInput[1 : len(indicatorInput)-1] {
+	for i, light := range indicatorInput {
 		if light == '.' {
 			continue
 		}
 
+		if light != '#' {
+			return Machine{}, &input.FieldError{
+				Offset: offsets[0] + 1 + i,
+				Field:  string(light),
+				Err:    errors.New("indicator lights must be . or #"),
+			}
+		}
+
 		indicator = indicator | (1 << i)
 	}
 
-	var (
-		joltageTarget []int
-		joltageInputs = strings.Split(joltageInput[1:len(joltageInput)-1], ",")
-	)
-	for _, joltageInput := range joltageInputs {
-		joltage, err := strconv.Atoi(joltageInput)
-		if err != nil {
-			panic(err)
-		}
+	joltageInput, err := unwrapField(offsets[last], parts[last], '{', '}')
+	if err != nil {
+		return Machine{}, err
+	}
 
-		joltageTarget = append(joltageTarget, joltage)
+	joltageTarget, err := input.Collect(input.IntFields(joltageInput, ","))
+	if err != nil {
+		return Machine{}, input.ShiftOffset(err, offsets[last]+1)
 	}
 
 	var buttons []int
-	for _, buttonInput := range buttonInputs {
-		flips := strings.Split(buttonInput[1:len(buttonInput)-1], ",")
+	for i := 1; i < last; i++ {
+		buttonInput, err := unwrapField(offsets[i], parts[i], '(', ')')
+		if err != nil {
+			return Machine{}, err
+		}
 
 		var button int
-		for _, flipIndicator := range flips {
-			value, err := strconv.Atoi(flipIndicator)
+		for flipIndicator, err := range input.IntFields(buttonInput, ",") {
 			if err != nil {
-				panic(err)
+				return Machine{}, input.ShiftOffset(err, offsets[i]+1)
 			}
 
-			button = button | (1 << value)
+			if flipIndicator < 0 || flipIndicator >= len(joltageTarget) {
+				return Machine{}, &input.FieldError{
+					Offset: offsets[i],
+					Field:  parts[i],
+					Err:    fmt.Errorf("button flips indicator %d but there are only %d", flipIndicator, len(joltageTarget)),
+				}
+			}
+
+			button = button | (1 << flipIndicator)
 		}
 
 		buttons = append(buttons, button)
@@ -499,7 +574,21 @@ func ParseMachine(input string) Machine {
 		JoltageTarget:   joltageTarget,
 		Joltages:        make([]int, len(joltageTarget)),
 		Buttons:         buttons,
+	}, nil
+}
+
+// unwrapField strips the opening and closing brackets from the field at the
+// given offset.
+func unwrapField(offset int, field string, opening, closing byte) (string, error) {
+	if len(field) < 2 || field[0] != opening || field[len(field)-1] != closing {
+		return "", &input.FieldError{
+			Offset: offset,
+			Field:  field,
+			Err:    fmt.Errorf("expected a list wrapped in %c%c", opening, closing),
+		}
 	}
+
+	return field[1 : len(field)-1], nil
 }
 
 func (m Machine) CanComplete() bool {
@@ -0,0 +1,122 @@
+package day11
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strings"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/containers"
+	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/progress"
+	"bbuck.dev/aoc2025/solver"
+)
+
+func init() {
+	solver.Register(2025, 11, New)
+}
+
+type Solver struct {
+	logger *slog.Logger
+	graph  *containers.DirectedGraph[string]
+}
+
+func New(configuration config.Config) solver.Solver {
+	return &Solver{
+		logger: configuration.Logger(),
+	}
+}
+
+func (s *Solver) Parse(lines []string) error {
+	s.graph = containers.NewDirectedGraph[string]()
+	for i, line := range lines {
+		node, outgoing, err := parseLine(line)
+		if err != nil {
+			return input.LineError(i, line, err)
+		}
+
+		s.graph.AddNode(node)
+		for _, outgoingNode := range outgoing {
+			s.graph.AddNode(outgoingNode)
+			s.graph.AddEdge(node, outgoingNode, "outgoing")
+		}
+	}
+
+	return nil
+}
+
+func (s *Solver) SolvePart1(ctx context.Context) (any, error) {
+	return SolvePart1(ctx, s.logger, s.graph)
+}
+
+func (s *Solver) SolvePart2(_ context.Context) (any, error) {
+	return SolvePart2(s.graph)
+}
+
+func SolvePart1(ctx context.Context, logger *slog.Logger, graph *containers.DirectedGraph[string]) (int, error) {
+	paths, err := FindPathsFromTo(ctx, logger, "you", "out", graph)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(paths), nil
+}
+
+func SolvePart2(graph *containers.DirectedGraph[string]) (*big.Int, error) {
+	paths, err := graph.CountPaths("svr", "out", "outgoing", containers.Waypoints[string]{
+		Required: []string{"dac", "fft"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot count paths: %w", err)
+	}
+
+	return paths, nil
+}
+
+func FindPathsFromTo(ctx context.Context, logger *slog.Logger, from, to string, graph *containers.DirectedGraph[string]) ([][]string, error) {
+	if !graph.HasNode(from) {
+		return nil, fmt.Errorf("graph does not have node %v", from)
+	}
+
+	tracker := progress.Start(ctx, "paths explored", 0)
+	defer tracker.Finish()
+
+	explore := func(string) error {
+		tracker.Increment()
+
+		return ctx.Err()
+	}
+
+	var paths [][]string
+	for path, err := range containers.AllPaths(graph.Follow("outgoing"), from, to, explore) {
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Debug("found path", "path", path)
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+func parseLine(line string) (string, []string, error) {
+	node, rest, found := strings.Cut(line, ": ")
+	if !found || node == "" {
+		return "", nil, errors.New(`expected a device name followed by ": "`)
+	}
+
+	outgoing := strings.Fields(rest)
+	if len(outgoing) == 0 {
+		return "", nil, &input.FieldError{
+			Offset: len(node) + 2,
+			Field:  rest,
+			Err:    errors.New("device has no outputs"),
+		}
+	}
+
+	return node, outgoing, nil
+}
@@ -1,76 +1,109 @@
-package main
+package day12
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"runtime"
+	"log/slog"
 	"slices"
 	"strings"
-	"sync"
 
 	"bbuck.dev/aoc2025/config"
 	"bbuck.dev/aoc2025/containers"
 	"bbuck.dev/aoc2025/grid"
 	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/pool"
+	"bbuck.dev/aoc2025/progress"
+	"bbuck.dev/aoc2025/solver"
 )
 
-func main() {
-	configuration := config.Parse()
-	lines, err := input.ReadInput(configuration, "day12")
-	if err != nil {
-		panic(err)
-	}
+func init() {
+	solver.Register(2025, 12, New)
+}
 
-	presents := make([]Present, 0, 6)
-	for range 6 {
-		input := lines[0:4]
-		present := ParsePresent(input)
-		presents = append(presents, present)
-		lines = lines[5:]
-	}
+type Solver struct {
+	logger   *slog.Logger
+	presents []Present
+	spaces   []*Space
+}
 
-	var spaces []*Space
-	for _, line := range lines {
-		space := ParseSpace(line)
-		spaces = append(spaces, space)
+func New(configuration config.Config) solver.Solver {
+	return &Solver{
+		logger: configuration.Logger(),
 	}
-
-	SolvePart1(spaces, presents)
 }
 
-func SolvePart1(spaces []*Space, presents []Present) {
-	var (
-		wg     = new(sync.WaitGroup)
-		tokens = make(chan struct{}, runtime.NumCPU())
-		found  = make(chan struct{}, len(spaces))
-	)
+func (s *Solver) Parse(lines []string) error {
+	s.presents = make([]Present, 0, 6)
 
-	for _, space := range spaces {
-		wg.Go(func() {
-			fits := space.Fits(presents)
+	sections := 0
+	for start, section := range input.Sections(lines) {
+		sections++
+		if len(s.presents) < 6 {
+			present, err := ParsePresent(start, section)
+			if err != nil {
+				return err
+			}
+
+			s.presents = append(s.presents, present)
+
+			continue
+		}
 
-			tokens <- struct{}{}
-			defer func() {
-				<-tokens
-			}()
+		if s.spaces != nil {
+			return input.LineError(start, section[0], errors.New("unexpected section after the spaces"))
+		}
 
-			if fits {
-				found <- struct{}{}
+		for i, line := range section {
+			space, err := ParseSpace(line)
+			if err != nil {
+				return input.LineError(start+i, line, fmt.Errorf("failed to parse space: %w", err))
 			}
 
-			fmt.Print(".")
-		})
+			s.spaces = append(s.spaces, space)
+		}
+	}
+
+	if sections != 7 {
+		return fmt.Errorf("expected 6 presents and a list of spaces, found %d sections", sections)
 	}
 
-	wg.Wait()
-	close(found)
+	return nil
+}
+
+func (s *Solver) SolvePart1(ctx context.Context) (any, error) {
+	return SolvePart1(ctx, s.logger, s.spaces, s.presents)
+}
+
+func (s *Solver) SolvePart2(_ context.Context) (any, error) {
+	return nil, solver.ErrNotImplemented
+}
+
+func SolvePart1(ctx context.Context, logger *slog.Logger, spaces []*Space, presents []Present) (int, error) {
+	tracker := progress.Start(ctx, "spaces", len(spaces))
+	defer tracker.Finish()
 
 	var count int
-	for range found {
-		count++
+	for fits, err := range pool.Unordered(0, spaces, func(space *Space) (bool, error) {
+		fits, err := space.Fits(ctx, presents)
+		if err == nil && logger.Enabled(ctx, slog.LevelDebug) {
+			logger.Debug("space checked", "space", space, "fits", fits, "layout", space.Debug())
+		}
+
+		return fits, err
+	}) {
+		if err != nil {
+			return 0, err
+		}
+
+		if fits {
+			count++
+		}
+
+		tracker.Increment()
 	}
 
-	fmt.Println()
-	fmt.Println(count)
+	return count, nil
 }
 
 type Space struct {
@@ -80,18 +113,24 @@ type Space struct {
 	islands *grid.Grid[int]
 }
 
-func ParseSpace(line string) *Space {
-	var rows, cols int
-	counts := make([]int, 6)
+func ParseSpace(line string) (*Space, error) {
+	values, err := input.Collect(input.Ints(line))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) != 8 {
+		return nil, fmt.Errorf("expected a size and 6 counts, found %d numbers", len(values))
+	}
 
-	fmt.Sscanf(line, "%dx%d: %d %d %d %d %d %d", &cols, &rows, &counts[0], &counts[1], &counts[2], &counts[3], &counts[4], &counts[5])
+	cols, rows, counts := values[0], values[1], values[2:]
 
 	return &Space{
 		Name:    fmt.Sprintf("%dx%d", rows, cols),
 		Layout:  grid.NewGrid[bool](rows, cols),
 		islands: grid.NewGrid[int](rows, cols),
 		Counts:  counts,
-	}
+	}, nil
 }
 
 func (space *Space) Islands() []int {
@@ -130,7 +169,7 @@ func (space *Space) checkIsland(loc grid.Location, currentIsland int) {
 	}
 }
 
-func (space *Space) Fits(presents []Present) bool {
+func (space *Space) Fits(ctx context.Context, presents []Present) (bool, error) {
 	var sum int
 	for _, count := range space.Counts {
 		sum += count
@@ -147,7 +186,7 @@ func (space *Space) Fits(presents []Present) bool {
 
 	availableArea := space.Layout.RowLen() * space.Layout.ColumnLen()
 	if totalArea > availableArea {
-		return false
+		return false, nil
 	}
 
 	slices.SortFunc(expandedPresents, func(a int, b int) int {
@@ -160,12 +199,16 @@ func (space *Space) Fits(presents []Present) bool {
 		return diff
 	})
 
-	return space.fitsRecurse(presents, expandedPresents, 0, 0)
+	return space.fitsRecurse(ctx, presents, expandedPresents, 0, 0)
 }
 
-func (space *Space) fitsRecurse(allPresents []Present, presentIndexes []int, index, startAt int) bool {
+func (space *Space) fitsRecurse(ctx context.Context, allPresents []Present, presentIndexes []int, index, startAt int) (bool, error) {
 	if index >= len(presentIndexes) {
-		return true
+		return true, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return false, err
 	}
 
 	presentIndex := presentIndexes[index]
@@ -187,8 +230,9 @@ func (space *Space) fitsRecurse(allPresents []Present, presentIndexes []int, ind
 					nextStart = locationIndex
 				}
 
-				if space.fitsRecurse(allPresents, presentIndexes, index+1, nextStart) {
-					return true
+				fits, err := space.fitsRecurse(ctx, allPresents, presentIndexes, index+1, nextStart)
+				if fits || err != nil {
+					return fits, err
 				}
 
 				shape.RemoveFrom(space.Layout, loc)
@@ -196,7 +240,7 @@ func (space *Space) fitsRecurse(allPresents []Present, presentIndexes []int, ind
 		}
 	}
 
-	return false
+	return false, nil
 }
 
 func (space *Space) Debug() string {
@@ -238,18 +282,7 @@ func NewShape(points []grid.Location) Shape {
 }
 
 func (s Shape) Equals(other Shape) bool {
-	set := containers.NewSet[grid.Location]()
-	for _, point := range s.points {
-		set.Add(point)
-	}
-
-	for _, point := range other.points {
-		if !set.Has(point) {
-			return false
-		}
-	}
-
-	return true
+	return containers.NewSetFromSlice(s.points).Equal(containers.NewSetFromSlice(other.points))
 }
 
 func (s *Shape) Area() int {
@@ -370,10 +403,22 @@ type Present struct {
 	Shapes []Shape
 }
 
-func ParsePresent(lines []string) Present {
+func ParsePresent(start int, lines []string) (Present, error) {
+	if len(lines) != 4 {
+		return Present{}, input.LineError(start, lines[0], fmt.Errorf("present should have a label and 3 rows, found %d lines", len(lines)))
+	}
+
 	basePoints := make([]grid.Location, 0, 9)
 	for r := 1; r < len(lines); r++ {
+		if len(lines[r]) != 3 {
+			return Present{}, input.LineError(start+r, lines[r], errors.New("present rows must be 3 wide"))
+		}
+
 		for c, char := range lines[r] {
+			if char != '#' && char != '.' {
+				return Present{}, input.ColumnError(start+r, c+1, lines[r], fmt.Errorf("%q is not # or .", char))
+			}
+
 			if char == '#' {
 				basePoints = append(basePoints, grid.NewLocation(r-1, c))
 			}
@@ -411,7 +456,7 @@ func ParsePresent(lines []string) Present {
 		shape.Anchor()
 	}
 
-	return Present{shapes}
+	return Present{shapes}, nil
 }
 
 func (p Present) Area() int {
@@ -1,58 +1,89 @@
-package main
+package day2
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math"
 	"os"
 	"strconv"
 	"strings"
-	"sync"
 
 	"bbuck.dev/aoc2025/config"
 	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/pool"
+	"bbuck.dev/aoc2025/solver"
 )
 
-func main() {
-	c := config.Parse()
-	scanner, cleanUp, err := input.GetScanner(c, "day2")
-	if err != nil {
-		panic(err)
+func init() {
+	solver.Register(2025, 2, New)
+}
+
+type Range struct {
+	Start, End int64
+}
+
+type Solver struct {
+	ranges []Range
+}
+
+func New(_ config.Config) solver.Solver {
+	return new(Solver)
+}
+
+func (s *Solver) Parse(lines []string) error {
+	if len(lines) == 0 {
+		return errors.New("input is empty")
 	}
-	defer cleanUp()
 
-	scanner.Scan()
-	ranges := strings.Split(scanner.Text(), ",")
+	for offset, rangeInput := range input.Fields(lines[0], ",") {
+		bounds, err := input.Collect(input.IntFields(rangeInput, "-"))
+		if err != nil {
+			return input.LineError(0, lines[0], input.ShiftOffset(fmt.Errorf("failed to parse range %q: %w", rangeInput, err), offset))
+		}
 
-	values := make(chan int64, 100)
-	var wg sync.WaitGroup
+		if len(bounds) != 2 {
+			return input.ColumnError(0, offset+1, lines[0], fmt.Errorf("range %q does not have a start and an end", rangeInput))
+		}
 
-	for _, rangeInput := range ranges {
-		wg.Go(func() {
-			findBadIds(rangeInput, values)
-		})
+		s.ranges = append(s.ranges, Range{int64(bounds[0]), int64(bounds[1])})
 	}
 
-	go func() {
-		wg.Wait()
-		close(values)
-	}()
+	return nil
+}
+
+func (s *Solver) SolvePart1(_ context.Context) (any, error) {
+	return s.sumBadIds(matchesPart1)
+}
 
+func (s *Solver) SolvePart2(_ context.Context) (any, error) {
+	return s.sumBadIds(matchesPart2)
+}
+
+func (s *Solver) sumBadIds(matches func(int64) bool) (int64, error) {
 	var sum int64
-	for value := range values {
+	for value, err := range pool.Unordered(0, s.ranges, func(idRange Range) (int64, error) {
+		return sumBadIdsInRange(idRange, matches), nil
+	}) {
+		if err != nil {
+			return 0, err
+		}
+
 		sum += value
 	}
 
-	fmt.Println(sum)
+	return sum, nil
 }
 
-func findBadIds(rangeInput string, values chan<- int64) {
-	rangeStart, rangeEnd := parseRange(rangeInput)
-
-	for i := rangeStart; i <= rangeEnd; i++ {
-		if matchesPart2(i) {
-			values <- i
+func sumBadIdsInRange(idRange Range, matches func(int64) bool) int64 {
+	var sum int64
+	for i := idRange.Start; i <= idRange.End; i++ {
+		if matches(i) {
+			sum += i
 		}
 	}
+
+	return sum
 }
 
 func matchesPart2(num int64) bool {
@@ -163,19 +194,3 @@ func orderOfMagnitude(i int64) int64 {
 
 	return -1
 }
-
-func parseRange(rangeInput string) (int64, int64) {
-	rangeParts := strings.Split(rangeInput, "-")
-	rangeStart, err := strconv.ParseInt(rangeParts[0], 10, 64)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Cannot parse %q as integer", rangeParts[0])
-		os.Exit(1)
-	}
-	rangeEnd, err := strconv.ParseInt(rangeParts[1], 10, 64)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Cannot parse %q as integer", rangeParts[1])
-		os.Exit(2)
-	}
-
-	return rangeStart, rangeEnd
-}
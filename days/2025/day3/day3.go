@@ -0,0 +1,151 @@
+package day3
+
+import (
+	"context"
+	"fmt"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/solver"
+)
+
+// joltageDigits is how many batteries are turned on in each bank in part 2.
+var joltageDigits = config.Param[int]{
+	Name:    "digits",
+	Usage:   "Number of batteries turned on in each bank in part 2",
+	Sample:  12,
+	Problem: 12,
+}
+
+func init() {
+	solver.Register(2025, 3, New, joltageDigits)
+}
+
+type Solver struct {
+	configuration config.Config
+	banks         [][]int
+}
+
+func New(configuration config.Config) solver.Solver {
+	return &Solver{
+		configuration: configuration,
+	}
+}
+
+func (s *Solver) Parse(lines []string) error {
+	for i, line := range lines {
+		bank, err := convertToBank(i, line)
+		if err != nil {
+			return err
+		}
+
+		s.banks = append(s.banks, bank)
+	}
+
+	return nil
+}
+
+func (s *Solver) SolvePart1(_ context.Context) (any, error) {
+	var sum int
+	for _, bank := range s.banks {
+		joltage := getJoltageSimple(bank)
+		sum += joltage
+	}
+
+	return sum, nil
+}
+
+func (s *Solver) SolvePart2(_ context.Context) (any, error) {
+	digits, err := joltageDigits.Get(s.configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	var sum int
+	for i, bank := range s.banks {
+		if digits < 1 || digits > len(bank) {
+			return nil, fmt.Errorf("bank %d cannot turn on %d of its %d batteries", i+1, digits, len(bank))
+		}
+
+		joltage := getJoltageLarge(bank, digits)
+		sum += joltage
+	}
+
+	return sum, nil
+}
+
+func convertToBank(index int, line string) ([]int, error) {
+	var bank []int
+	for offset, c := range line {
+		if c < '0' || c > '9' {
+			return nil, input.ColumnError(index, offset+1, line, fmt.Errorf("battery %q is not a digit", c))
+		}
+
+		bank = append(bank, int(c-'0'))
+	}
+
+	return bank, nil
+}
+
+func getJoltageLarge(bank []int, digits int) int {
+	var (
+		joltage int
+		bankLen = len(bank)
+		stop    = -1
+	)
+
+	for i := digits; i > 0; i-- {
+		var (
+			start   = bankLen - i
+			foundAt = -1
+			digit   = -1
+		)
+
+		for j := start; j > stop; j-- {
+			if bank[j] >= digit {
+				digit = bank[j]
+				foundAt = j
+			}
+		}
+
+		stop = foundAt
+		joltage = (joltage * 10) + digit
+	}
+
+	return joltage
+}
+
+func getJoltageSimple(bank []int) int {
+	maxTen := -1
+	maxOne := -1
+
+	bankLen := len(bank)
+	for i := 0; i < bankLen; i++ {
+		if bank[i] < maxTen {
+			continue
+		}
+
+		oldMaxOne := maxOne
+		if bank[i] > maxTen {
+			maxOne = -1
+		}
+
+		wasSet := false
+		for j := i + 1; j < bankLen; j++ {
+			if bank[j] <= maxOne {
+				continue
+			}
+
+			maxOne = bank[j]
+			wasSet = true
+		}
+
+		if wasSet {
+			maxTen = bank[i]
+		} else {
+			maxOne = oldMaxOne
+		}
+	}
+
+	return (maxTen * 10) + maxOne
+}
@@ -0,0 +1,183 @@
+package day4
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/grid"
+	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/solver"
+)
+
+// neighbourLimit is how many neighbouring rolls make a roll inaccessible.
+var neighbourLimit = config.Param[int]{
+	Name:    "neighbour-limit",
+	Usage:   "Rolls with fewer neighbouring rolls than this can be reached",
+	Sample:  4,
+	Problem: 4,
+}
+
+func init() {
+	solver.Register(2025, 4, New, neighbourLimit)
+}
+
+type Solver struct {
+	configuration config.Config
+	rollMap       *Map
+}
+
+func New(configuration config.Config) solver.Solver {
+	return &Solver{
+		configuration: configuration,
+	}
+}
+
+func (s *Solver) Parse(lines []string) error {
+	if len(lines) == 0 {
+		return errors.New("input is empty")
+	}
+
+	rolls, err := input.LoadGrid(lines, parseRoll)
+	if err != nil {
+		return err
+	}
+
+	s.rollMap = NewMap(rolls.RowLen(), rolls.ColumnLen())
+	for location, roll := range rolls.Iter() {
+		if roll {
+			s.rollMap.AddRoll(location)
+		}
+	}
+
+	return nil
+}
+
+func (s *Solver) SolvePart1(_ context.Context) (any, error) {
+	limit, err := neighbourLimit.Get(s.configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	var accessibleCount int
+	for _, cell := range s.rollMap.Iter() {
+		if cell.Accessible(limit) {
+			accessibleCount++
+		}
+	}
+
+	return accessibleCount, nil
+}
+
+func (s *Solver) SolvePart2(_ context.Context) (any, error) {
+	limit, err := neighbourLimit.Get(s.configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	var removedRollCount int
+	for {
+		var toRemove []grid.Location
+		for location, cell := range s.rollMap.Iter() {
+			if cell.Accessible(limit) {
+				toRemove = append(toRemove, location)
+			}
+		}
+
+		for _, l := range toRemove {
+			s.rollMap.RemoveRoll(l)
+		}
+
+		removedRollCount += len(toRemove)
+		if len(toRemove) == 0 {
+			break
+		}
+	}
+
+	return removedRollCount, nil
+}
+
+type Cell struct {
+	ContainsRoll bool
+	NearbyRolls  int
+}
+
+func (c Cell) Accessible(neighbourLimit int) bool {
+	return c.ContainsRoll && c.NearbyRolls < neighbourLimit
+}
+
+func parseRoll(char rune) (bool, error) {
+	switch char {
+	case '@':
+		return true, nil
+
+	case '.':
+		return false, nil
+	}
+
+	return false, fmt.Errorf("%q is not @ or .", char)
+}
+
+type Map struct {
+	grid *grid.Grid[Cell]
+}
+
+func NewMap(rowCount, columnCount int) *Map {
+	return &Map{
+		grid: grid.NewGrid[Cell](rowCount, columnCount),
+	}
+}
+
+func (m Map) Iter() iter.Seq2[grid.Location, Cell] {
+	return m.grid.Iter()
+}
+
+func (m Map) AddRoll(location grid.Location) {
+	if !m.grid.ValidLocation(location) {
+		return
+	}
+
+	m.grid.UpdateAt(location, func(cell Cell) Cell {
+		cell.ContainsRoll = true
+
+		return cell
+	})
+
+	for _, n := range location.Neighbors() {
+		m.Increment(n)
+	}
+}
+
+func (m Map) RemoveRoll(location grid.Location) {
+	if !m.grid.ValidLocation(location) {
+		return
+	}
+
+	m.grid.UpdateAt(location, func(cell Cell) Cell {
+		cell.ContainsRoll = false
+
+		return cell
+	})
+
+	for _, n := range location.Neighbors() {
+		m.Decrement(n)
+	}
+}
+
+func (m Map) Decrement(location grid.Location) {
+	m.grid.UpdateAt(location, func(cell Cell) Cell {
+		cell.NearbyRolls--
+
+		return cell
+	})
+}
+
+func (m Map) Increment(location grid.Location) {
+	m.grid.UpdateAt(location, func(cell Cell) Cell {
+		cell.NearbyRolls++
+
+		return cell
+	})
+}
@@ -0,0 +1,178 @@
+package day5
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/solver"
+)
+
+func init() {
+	solver.Register(2025, 5, New)
+}
+
+type Solver struct {
+	ranges []Range
+	ids    []int
+}
+
+func New(_ config.Config) solver.Solver {
+	return new(Solver)
+}
+
+func (s *Solver) Parse(lines []string) error {
+	section := 0
+	for start, sectionLines := range input.Sections(lines) {
+		switch section {
+		case 0:
+			for i, line := range sectionLines {
+				newRange, err := ParseRange(line)
+				if err != nil {
+					return input.LineError(start+i, line, fmt.Errorf("failed to parse range: %w", err))
+				}
+
+				s.ranges = append(s.ranges, newRange)
+			}
+
+		case 1:
+			for i, line := range sectionLines {
+				id, err := strconv.Atoi(line)
+				if err != nil {
+					return input.ColumnError(start+i, 1, line, fmt.Errorf("ingredient id %q is not a number", line))
+				}
+
+				s.ids = append(s.ids, id)
+			}
+
+		default:
+			return input.LineError(start, sectionLines[0], errors.New("unexpected section after the ingredient ids"))
+		}
+
+		section++
+	}
+
+	if section == 0 {
+		return errors.New("input has no ranges")
+	}
+
+	return nil
+}
+
+func (s *Solver) SolvePart1(_ context.Context) (any, error) {
+	var freshCount int
+	for _, id := range s.ids {
+		fresh := slices.ContainsFunc(s.ranges, func(r Range) bool {
+			return r.Contains(id)
+		})
+
+		if fresh {
+			freshCount++
+		}
+	}
+
+	return freshCount, nil
+}
+
+func (s *Solver) SolvePart2(_ context.Context) (any, error) {
+	ranges := slices.Clone(s.ranges)
+	slices.SortFunc(ranges, func(a Range, b Range) int {
+		if a.Minimum < b.Minimum {
+			return -1
+		}
+
+		if a.Minimum > b.Minimum {
+			return 1
+		}
+
+		return 0
+	})
+
+	var mergedRanges []Range
+	if len(ranges) > 0 {
+		current := ranges[0]
+
+		for _, r := range ranges {
+			if current.Overlaps(r) {
+				current = current.Merge(r)
+			} else {
+				mergedRanges = append(mergedRanges, current)
+				current = r
+			}
+		}
+
+		ranges = append(mergedRanges, current)
+	}
+
+	var freshCount int
+	for _, r := range ranges {
+		freshCount += r.Count()
+	}
+
+	return freshCount, nil
+}
+
+type Range struct {
+	Minimum int
+	Maximum int
+}
+
+func ParseRange(line string) (Range, error) {
+	startStr, endStr, found := strings.Cut(line, "-")
+	var newRange Range
+
+	if !found {
+		return newRange, errors.New("not a valid range string")
+	}
+
+	start, err := strconv.Atoi(startStr)
+	if err != nil {
+		return newRange, &input.FieldError{
+			Offset: 0,
+			Field:  startStr,
+			Err:    fmt.Errorf("range start is %w", input.ErrNotInteger),
+		}
+	}
+
+	end, err := strconv.Atoi(endStr)
+	if err != nil {
+		return newRange, &input.FieldError{
+			Offset: len(startStr) + 1,
+			Field:  endStr,
+			Err:    fmt.Errorf("range end is %w", input.ErrNotInteger),
+		}
+	}
+
+	newRange.Minimum = start
+	newRange.Maximum = end
+
+	return newRange, nil
+}
+
+func (r Range) String() string {
+	return fmt.Sprintf("%d - %d", r.Minimum, r.Maximum)
+}
+
+func (r Range) Contains(value int) bool {
+	return value >= r.Minimum && value <= r.Maximum
+}
+
+func (r Range) Overlaps(other Range) bool {
+	return r.Minimum <= other.Maximum && r.Maximum >= other.Minimum
+}
+
+func (r Range) Merge(other Range) Range {
+	return Range{
+		Minimum: min(r.Minimum, other.Minimum),
+		Maximum: max(r.Maximum, other.Maximum),
+	}
+}
+
+func (r Range) Count() int {
+	return r.Maximum - r.Minimum + 1
+}
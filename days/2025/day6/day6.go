@@ -0,0 +1,257 @@
+package day6
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/solver"
+)
+
+func init() {
+	solver.Register(2025, 6, New)
+}
+
+type Solver struct {
+	lines [][]rune
+}
+
+func New(_ config.Config) solver.Solver {
+	return new(Solver)
+}
+
+func (s *Solver) Parse(lines []string) error {
+	if len(lines) < 2 {
+		return errors.New("input needs at least one row of numbers and a row of operations")
+	}
+
+	var (
+		lineCount = len(lines) - 1
+		width     int
+	)
+	for _, line := range lines {
+		width = max(width, len(line))
+	}
+
+	operationLine := lines[lineCount]
+	operations := 0
+	for offset, char := range operationLine {
+		if char == ' ' {
+			continue
+		}
+
+		if _, err := ParseOperation(char); err != nil {
+			return input.ColumnError(lineCount, offset+1, operationLine, err)
+		}
+
+		operations++
+	}
+
+	for i, line := range lines[:lineCount] {
+		numbers := 0
+		for offset, char := range line {
+			if char != ' ' && (char < '0' || char > '9') {
+				return input.ColumnError(i, offset+1, line, fmt.Errorf("%q is not a digit", char))
+			}
+
+			if char != ' ' && (offset == 0 || line[offset-1] == ' ') {
+				numbers++
+			}
+		}
+
+		if numbers != operations {
+			return input.LineError(i, line, fmt.Errorf("row has %d numbers but there are %d operations", numbers, operations))
+		}
+	}
+
+	// pad every line to the same width so columns can be read top to bottom
+	for _, line := range lines {
+		padded := []rune(line)
+		for len(padded) < width {
+			padded = append(padded, ' ')
+		}
+
+		s.lines = append(s.lines, padded)
+	}
+
+	return nil
+}
+
+func (s *Solver) SolvePart1(_ context.Context) (any, error) {
+	var (
+		lineCount = len(s.lines) - 1
+		problems  []*Problem
+		answer    int
+	)
+	for _, operation := range strings.Fields(string(s.lines[lineCount])) {
+		problem := NewProblem()
+		problem.Operation, _ = ParseOperation([]rune(operation)[0])
+
+		problems = append(problems, problem)
+	}
+
+	for _, line := range s.lines[:lineCount] {
+		numbers, err := input.Collect(input.IntFields(string(line), ""))
+		if err != nil {
+			return nil, err
+		}
+
+		for i, number := range numbers {
+			problems[i].AddNumber(number)
+		}
+	}
+
+	for _, problem := range problems {
+		answer += problem.Execute()
+	}
+
+	return answer, nil
+}
+
+func (s *Solver) SolvePart2(_ context.Context) (any, error) {
+	var (
+		lines     = s.lines
+		lineCount = len(lines) - 1
+		column    = len(lines[0]) - 1
+		answer    int
+	)
+	for column > 0 {
+		var problem = NewProblem()
+
+		for {
+			var number int
+
+			for i := range lineCount {
+				if lines[i][column] == ' ' {
+					continue
+				}
+
+				digit := lines[i][column] - '0'
+
+				number = (number * 10) + int(digit)
+			}
+
+			problem.AddNumber(number)
+
+			if lines[lineCount][column] != ' ' {
+				problem.Operation, _ = ParseOperation(lines[lineCount][column])
+
+				answer += problem.Execute()
+
+				// skip seperate column too
+				column -= 2
+
+				break
+			}
+
+			column--
+		}
+	}
+
+	return answer, nil
+}
+
+func Map[Slice ~[]E, E any, U any](slice Slice, mapper func(E) U) []U {
+	var newSlice []U
+
+	for _, item := range slice {
+		newSlice = append(newSlice, mapper(item))
+	}
+
+	return newSlice
+}
+
+type Operation int
+
+const (
+	OperationAdd Operation = iota
+	OperationMultiply
+)
+
+func ParseOperation(char rune) (Operation, error) {
+	switch char {
+	case '+':
+		return OperationAdd, nil
+
+	case '*':
+		return OperationMultiply, nil
+
+	default:
+		return OperationAdd, fmt.Errorf("unknown operation %q", char)
+	}
+}
+
+func (o Operation) Execute(a, b int) int {
+	switch o {
+	case OperationAdd:
+		return a + b
+
+	case OperationMultiply:
+		return a * b
+	}
+
+	return 0
+}
+
+func (o Operation) String() string {
+	switch o {
+	case OperationAdd:
+		return "+"
+
+	case OperationMultiply:
+		return "*"
+
+	default:
+		return "~"
+	}
+}
+
+type Problem struct {
+	Numbers   []int
+	Operation Operation
+}
+
+func NewProblem() *Problem {
+	return &Problem{
+		Numbers: make([]int, 0),
+	}
+}
+
+func (p *Problem) AddNumber(num int) {
+	p.Numbers = append(p.Numbers, num)
+}
+
+func (p Problem) Execute() int {
+	if len(p.Numbers) == 0 {
+		return 0
+	}
+
+	result := p.Numbers[0]
+	for _, n := range p.Numbers[1:] {
+		result = p.Operation.Execute(result, n)
+	}
+
+	return result
+}
+
+func (p Problem) String() string {
+	builder := new(strings.Builder)
+
+	for i, n := range p.Numbers {
+		builder.WriteString(strconv.Itoa(n))
+		if i < len(p.Numbers)-1 {
+			builder.WriteRune(' ')
+			builder.WriteString(p.Operation.String())
+			builder.WriteRune(' ')
+		}
+	}
+
+	builder.WriteString(" = ")
+	builder.WriteString(strconv.Itoa(p.Execute()))
+
+	return builder.String()
+}
@@ -1,7 +1,10 @@
-package main
+package day7
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"maps"
 	"slices"
 	"strconv"
@@ -10,25 +13,59 @@ import (
 	"bbuck.dev/aoc2025/config"
 	"bbuck.dev/aoc2025/grid"
 	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/solver"
 )
 
-func main() {
-	configuration := config.Parse()
-	lines, err := input.ReadInput(configuration, "day7")
+func init() {
+	solver.Register(2025, 7, New)
+}
+
+type Solver struct {
+	logger  *slog.Logger
+	diagram *Diagram
+}
+
+func New(configuration config.Config) solver.Solver {
+	return &Solver{
+		logger: configuration.Logger(),
+	}
+}
+
+func (s *Solver) Parse(lines []string) error {
+	if len(lines) == 0 {
+		return errors.New("input is empty")
+	}
+
+	diagram, err := NewDiagram(lines)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	diagram := NewDiagram(lines)
+	s.diagram = diagram
+
+	return nil
+}
+
+func (s *Solver) SolvePart1(_ context.Context) (any, error) {
+	s.castAll(false)
+
+	return s.diagram.Splits, nil
+}
+
+func (s *Solver) SolvePart2(_ context.Context) (any, error) {
+	s.castAll(true)
+
+	s.logger.Debug("beams cast", "diagram", s.diagram)
+
+	return s.diagram.Timelines(), nil
+}
+
+func (s *Solver) castAll(allowOverlap bool) {
 	done := false
 	for !done {
-		shouldContinue := diagram.Cast(true)
+		shouldContinue := s.diagram.Cast(allowOverlap)
 		done = !shouldContinue
 	}
-
-	fmt.Println(diagram)
-	fmt.Println("Total Splits:", diagram.Splits)
-	fmt.Println("Total Timelines:", diagram.Timelines())
 }
 
 type Cell int
@@ -39,19 +76,19 @@ const (
 	CellSplitter
 )
 
-func CellFromRune(r rune) Cell {
+func CellFromRune(r rune) (Cell, error) {
 	switch r {
 	case '.':
-		return CellEmpty
+		return CellEmpty, nil
 
 	case 'S':
-		return CellStart
+		return CellStart, nil
 
 	case '^':
-		return CellSplitter
+		return CellSplitter, nil
 
 	default:
-		panic(fmt.Errorf("unknown cell value %c", r))
+		return CellEmpty, fmt.Errorf("unknown cell value %q", r)
 	}
 }
 
@@ -89,30 +126,23 @@ type Diagram struct {
 	completedBeams []*Beam
 }
 
-func NewDiagram(lines []string) *Diagram {
-	rowCount := len(lines)
-	columnCount := len(lines[0])
-
-	g := grid.NewGrid[Cell](rowCount, columnCount)
+func NewDiagram(lines []string) (*Diagram, error) {
+	g, err := input.LoadGrid(lines, CellFromRune)
+	if err != nil {
+		return nil, err
+	}
 
 	var activeBeams []*Beam
-	for r, line := range lines {
-		for c, char := range line {
-			loc := grid.NewLocation(r, c)
-			cell := CellFromRune(char)
-
-			if cell == CellStart {
-				activeBeams = append(activeBeams, NewBeam(loc, 1))
-			}
-
-			g.SetAt(loc, cell)
+	for loc, cell := range g.Iter() {
+		if cell == CellStart {
+			activeBeams = append(activeBeams, NewBeam(loc, 1))
 		}
 	}
 
 	return &Diagram{
 		Grid:        g,
 		activeBeams: activeBeams,
-	}
+	}, nil
 }
 
 func (d Diagram) beamMap() map[grid.Location]int {
@@ -0,0 +1,290 @@
+package day8
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/containers"
+	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/solver"
+)
+
+// targetJunctions is how many of the closest pairs of junction boxes are
+// connected in part 1.
+var targetJunctions = config.Param[int]{
+	Name:    "target-junctions",
+	Usage:   "Number of closest junction box pairs to connect in part 1",
+	Sample:  10,
+	Problem: 1_000,
+}
+
+func init() {
+	solver.Register(2025, 8, New, targetJunctions)
+}
+
+type Solver struct {
+	configuration config.Config
+	vectors       []Vector3
+}
+
+func New(configuration config.Config) solver.Solver {
+	return &Solver{
+		configuration: configuration,
+	}
+}
+
+func (s *Solver) Parse(lines []string) error {
+	for i, line := range lines {
+		vector, err := ParseVector3(line)
+		if err != nil {
+			return input.LineError(i, line, err)
+		}
+
+		s.vectors = append(s.vectors, vector)
+	}
+
+	return nil
+}
+
+func (s *Solver) SolvePart1(_ context.Context) (any, error) {
+	target, err := targetJunctions.Get(s.configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return solvePart1(target, slices.Clone(s.vectors))
+}
+
+func (s *Solver) SolvePart2(_ context.Context) (any, error) {
+	return solvePart2(s.configuration, s.vectors), nil
+}
+
+func solvePart2(_ config.Config, vectors []Vector3) int {
+	var (
+		lines  []Line3D
+		forest = containers.NewDisjointSetForest[Vector3]()
+	)
+	for i, vector := range vectors {
+		forest.NewSet(vector)
+
+		for j := i + 1; j < len(vectors); j++ {
+			lines = append(lines, NewLine(vector, vectors[j]))
+		}
+	}
+
+	slices.SortFunc(lines, func(a, b Line3D) int {
+		if a.Distance < b.Distance {
+			return -1
+		}
+
+		if a.Distance > b.Distance {
+			return 1
+		}
+
+		return 0
+	})
+
+	var result int
+	for _, line := range lines {
+		linked := forest.Union(line.Start, line.End)
+
+		if !linked {
+			continue
+		}
+
+		if forest.SetCount() == 1 {
+			result = line.Start.X * line.End.X
+
+			break
+		}
+	}
+
+	return result
+}
+
+func solvePart1(targetJunctions int, vectors []Vector3) (int, error) {
+	graph := containers.NewGraph[Vector3]()
+	for _, vector := range vectors {
+		graph.AddNode(vector)
+	}
+
+	slices.SortFunc(vectors, func(a, b Vector3) int {
+		if a.X < b.X {
+			return -1
+		}
+
+		if a.X > b.X {
+			return 1
+		}
+
+		return 0
+	})
+
+	heap := containers.NewHeap(func(a, b Line3D) bool {
+		return a.Distance > b.Distance
+	})
+	for i, vector := range vectors {
+		for j := i + 1; j < len(vectors); j++ {
+			otherVector := vectors[j]
+
+			xDist := math.Abs(float64(otherVector.X - vector.X))
+			maxLine, _ := heap.Peek()
+			if heap.Len() >= targetJunctions && xDist >= maxLine.Distance {
+				break
+			}
+
+			if heap.Len() < targetJunctions {
+				line := NewLine(vector, otherVector)
+				heap.Add(line)
+
+				continue
+			}
+
+			newLine := NewLine(vector, otherVector)
+			if newLine.Distance < maxLine.Distance {
+				heap.Remove()
+				heap.Add(newLine)
+			}
+		}
+	}
+
+	line3ds := slices.Collect(heap.Iter())
+	slices.SortFunc(line3ds, func(a, b Line3D) int {
+		if a.Distance < b.Distance {
+			return -1
+		}
+
+		if a.Distance > b.Distance {
+			return 1
+		}
+
+		return 0
+	})
+
+	for _, line := range line3ds {
+		graph.AddEdge(line.Start, line.End)
+	}
+
+	var circuits []Circuit
+	for _, junctions := range containers.ConnectedComponents(graph) {
+		circuits = append(circuits, Circuit{junctions: junctions})
+	}
+
+	slices.SortFunc(circuits, func(a, b Circuit) int {
+		var (
+			aLen = a.Len()
+			bLen = b.Len()
+		)
+
+		if aLen < bLen {
+			return 1
+		}
+
+		if aLen > bLen {
+			return -1
+		}
+
+		return 0
+	})
+
+	if len(circuits) < 3 {
+		return 0, fmt.Errorf("connecting %d pairs leaves %d circuits, at least 3 are needed", targetJunctions, len(circuits))
+	}
+
+	result := circuits[0].Len() * circuits[1].Len() * circuits[2].Len()
+
+	return result, nil
+}
+
+type Vector3 struct {
+	X, Y, Z int
+}
+
+func ParseVector3(s string) (Vector3, error) {
+	parts, err := input.Collect(input.IntFields(s, ","))
+	if err != nil {
+		return Vector3{}, err
+	}
+
+	if len(parts) != 3 {
+		return Vector3{}, fmt.Errorf("expected 3 comma separated numbers, found %d", len(parts))
+	}
+
+	return Vector3{parts[0], parts[1], parts[2]}, nil
+}
+
+func (v Vector3) DistanceTo(other Vector3) float64 {
+	xs := other.X - v.X
+	ys := other.Y - v.Y
+	zs := other.Z - v.Z
+
+	xs2 := math.Pow(float64(xs), 2)
+	ys2 := math.Pow(float64(ys), 2)
+	zs2 := math.Pow(float64(zs), 2)
+
+	return math.Sqrt(xs2 + ys2 + zs2)
+}
+
+func (v Vector3) String() string {
+	return fmt.Sprintf("(%d, %d, %d)", v.X, v.Y, v.Z)
+}
+
+type Line3D struct {
+	Start, End Vector3
+	Distance   float64
+}
+
+func NewLine(start, end Vector3) Line3D {
+	return Line3D{
+		Start:    start,
+		End:      end,
+		Distance: start.DistanceTo(end),
+	}
+}
+
+func (l Line3D) String() string {
+	return fmt.Sprintf("%s => %s (%f)", l.Start, l.End, l.Distance)
+}
+
+type Circuit struct {
+	junctions containers.Set[Vector3]
+}
+
+func NewCircuit() Circuit {
+	return Circuit{
+		junctions: containers.NewSet[Vector3](),
+	}
+}
+
+func (c Circuit) Add(junction Vector3) {
+	c.junctions.Add(junction)
+}
+
+func (c Circuit) Has(junction Vector3) bool {
+	return c.junctions.Has(junction)
+}
+
+func (c Circuit) Len() int {
+	return len(c.junctions)
+}
+
+func (c Circuit) String() string {
+	builder := new(strings.Builder)
+
+	builder.WriteString("Circuit (")
+	builder.WriteString(strconv.Itoa(len(c.junctions)))
+	builder.WriteString(")\n")
+
+	for junction := range c.junctions.Iter() {
+		builder.WriteRune('\t')
+		builder.WriteString(junction.String())
+		builder.WriteRune('\n')
+	}
+
+	return builder.String()
+}
@@ -1,63 +1,84 @@
-package main
+package day9
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"iter"
+	"log/slog"
 	"slices"
-	"strconv"
-	"strings"
 
 	"bbuck.dev/aoc2025/config"
 	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/solver"
 )
 
-func main() {
-	configuration := config.Parse()
-	lines, err := input.ReadInput(configuration, "day9")
-	if err != nil {
-		panic(err)
+func init() {
+	solver.Register(2025, 9, New)
+}
+
+type Solver struct {
+	logger  *slog.Logger
+	vectors []Vector2
+}
+
+func New(configuration config.Config) solver.Solver {
+	return &Solver{
+		logger: configuration.Logger(),
 	}
+}
 
-	var vectors []Vector2
-	for _, line := range lines {
+func (s *Solver) Parse(lines []string) error {
+	for i, line := range lines {
 		vector, err := ParseVector2(line)
 		if err != nil {
-			panic(err)
+			return input.LineError(i, line, err)
 		}
 
-		vectors = append(vectors, vector)
+		s.vectors = append(s.vectors, vector)
+	}
+
+	if len(s.vectors) < 2 {
+		return fmt.Errorf("expected at least 2 red tiles, found %d", len(s.vectors))
+	}
+
+	return nil
+}
+
+func (s *Solver) SolvePart1(_ context.Context) (any, error) {
+	return solvePart1(s.vectors), nil
+}
+
+func (s *Solver) SolvePart2(_ context.Context) (any, error) {
+	return solvePart2(s.logger, s.vectors)
+}
+
+func solvePart1(vectors []Vector2) int {
+	var rects []Rectangle2
+	for i, vector := range vectors {
+		for j := i + 1; j < len(vectors); j++ {
+			rect := NewRectangle2(vector, vectors[j])
+
+			rects = append(rects, rect)
+		}
 	}
 
-	solvePart2(vectors)
+	slices.SortFunc(rects, func(a, b Rectangle2) int {
+		if a.Area < b.Area {
+			return 1
+		}
+
+		if a.Area > b.Area {
+			return -1
+		}
+
+		return 0
+	})
+
+	return rects[0].Area
 }
 
-// func solvePart1(vectors []Vector2) {
-// 	var rects []Rectangle2
-// 	for i, vector := range vectors {
-// 		for j := i + 1; j < len(vectors); j++ {
-// 			rect := NewRectangle2(vector, vectors[j])
-//
-// 			rects = append(rects, rect)
-// 		}
-// 	}
-//
-// 	slices.SortFunc(rects, func(a, b Rectangle2) int {
-// 		if a.Area < b.Area {
-// 			return 1
-// 		}
-//
-// 		if a.Area > b.Area {
-// 			return -1
-// 		}
-//
-// 		return 0
-// 	})
-//
-// 	fmt.Println(rects[0].Area)
-// }
-
-func solvePart2(vectors []Vector2) {
+func solvePart2(logger *slog.Logger, vectors []Vector2) (int, error) {
 	polygon := NewPolygon2(vectors)
 
 	var rects []Rectangle2
@@ -83,9 +104,13 @@ func solvePart2(vectors []Vector2) {
 		return 0
 	})
 
-	fmt.Println(rects[0])
+	if len(rects) == 0 {
+		return 0, errors.New("no rectangle fits inside the red and green tiles")
+	}
 
-	fmt.Println(rects[0].Area)
+	logger.Info("largest rectangle", "rectangle", rects[0])
+
+	return rects[0].Area, nil
 }
 
 type Vector2 struct {
@@ -93,22 +118,16 @@ type Vector2 struct {
 }
 
 func ParseVector2(s string) (Vector2, error) {
-	parts := strings.Split(s, ",")
-	if len(parts) != 2 {
-		return Vector2{}, errors.New("invalid string given to ParseVector2")
-	}
-
-	x, err := strconv.Atoi(parts[0])
+	parts, err := input.Collect(input.IntFields(s, ","))
 	if err != nil {
 		return Vector2{}, err
 	}
 
-	y, err := strconv.Atoi(parts[1])
-	if err != nil {
-		return Vector2{}, err
+	if len(parts) != 2 {
+		return Vector2{}, fmt.Errorf("expected 2 comma separated numbers, found %d", len(parts))
 	}
 
-	return Vector2{x, y}, nil
+	return Vector2{parts[0], parts[1]}, nil
 }
 
 func (v Vector2) String() string {
@@ -0,0 +1,128 @@
+package input
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ParseError reports a problem with the puzzle input, pointing at the line
+// and column where it was found.
+type ParseError struct {
+	// File is the name of the input, filled in by the runner when the solver
+	// does not know it.
+	File string
+	// Line is the one based line number in the input.
+	Line int
+	// Column is the one based byte offset in the line, zero when unknown.
+	Column int
+	// Text is the full line the error was found on.
+	Text string
+	Err  error
+}
+
+// LineError creates a parse error for the line at the zero based index. When
+// err wraps a FieldError the column is taken from it.
+func LineError(index int, text string, err error) *ParseError {
+	column := 0
+
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		column = fieldErr.Offset + 1
+	}
+
+	return ColumnError(index, column, text, err)
+}
+
+// ColumnError creates a parse error for the line at the zero based index and
+// the one based column.
+func ColumnError(index, column int, text string, err error) *ParseError {
+	return &ParseError{
+		Line:   index + 1,
+		Column: column,
+		Text:   text,
+		Err:    err,
+	}
+}
+
+func (e *ParseError) Error() string {
+	builder := new(strings.Builder)
+
+	if e.File != "" {
+		builder.WriteString(e.File)
+		builder.WriteRune(':')
+	}
+
+	fmt.Fprintf(builder, "%d:", e.Line)
+	if e.Column > 0 {
+		fmt.Fprintf(builder, "%d:", e.Column)
+	}
+
+	builder.WriteRune(' ')
+	builder.WriteString(e.Err.Error())
+
+	return builder.String()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Diagnostic formats the error like a compiler would, followed by the
+// offending line and a marker under the column.
+func (e *ParseError) Diagnostic() string {
+	builder := new(strings.Builder)
+
+	builder.WriteString(e.Error())
+	builder.WriteString("\n    ")
+	builder.WriteString(e.Text)
+
+	if e.Column > 0 {
+		builder.WriteString("\n    ")
+
+		// keep tabs so the marker lines up with the text above it
+		for _, char := range e.Text[:min(e.Column-1, len(e.Text))] {
+			if char == '\t' {
+				builder.WriteRune('\t')
+			} else {
+				builder.WriteRune(' ')
+			}
+		}
+
+		builder.WriteRune('^')
+	}
+
+	return builder.String()
+}
+
+// FieldError reports a field of a line that could not be parsed.
+type FieldError struct {
+	// Offset is the zero based byte offset of the field in the line.
+	Offset int
+	Field  string
+	Err    error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("field %q: %s", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ErrNotInteger is wrapped by the errors of fields that should have been
+// integers.
+var ErrNotInteger = errors.New("not an integer")
+
+// ShiftOffset moves the offset of a FieldError wrapped by err by the given
+// amount, for fields parsed out of text that was itself cut from a line at
+// that offset. Other errors are returned untouched.
+func ShiftOffset(err error, offset int) error {
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		fieldErr.Offset += offset
+	}
+
+	return err
+}
@@ -2,22 +2,27 @@ package input
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
 
 	"bbuck.dev/aoc2025/config"
 )
 
-// GetScanner determines the correct file to use and loads it.
+// GetScanner determines the correct source to use and opens it.
 func GetScanner(configuration config.Config, day string) (*bufio.Scanner, func(), error) {
-	fileName := "sample"
-	if configuration.Solve {
-		fileName = "problem"
+	source, err := SourceFor(configuration, day)
+	if err != nil {
+		return nil, func() {}, err
 	}
 
-	filePath := fmt.Sprintf("%s/%s.in", day, fileName)
+	return OpenScanner(source)
+}
 
-	file, err := os.OpenInRoot("inputs", filePath)
+// OpenScanner opens the source and wraps it in a scanner, the returned
+// function closes the source.
+func OpenScanner(source Source) (*bufio.Scanner, func(), error) {
+	file, err := source.Open()
 	if err != nil {
 		return nil, func() {}, err
 	}
@@ -33,11 +38,32 @@ func GetScanner(configuration config.Config, day string) (*bufio.Scanner, func()
 
 // ReadInput reads all the input from the problem file into a string slice.
 func ReadInput(configuration config.Config, day string) ([]string, error) {
-	scanner, cleanUp, err := GetScanner(configuration, day)
+	source, err := SourceFor(configuration, day)
 	if err != nil {
 		return nil, err
 	}
-	defer cleanUp()
+
+	return ReadSource(source)
+}
+
+// ReadSource reads all the input from the source into a string slice.
+func ReadSource(source Source) ([]string, error) {
+	lines, _, err := ReadSourceDigest(source)
+
+	return lines, err
+}
+
+// ReadSourceDigest reads all the input from the source into a string slice
+// and also returns the hex encoded SHA-256 digest of the raw input.
+func ReadSourceDigest(source Source) ([]string, string, error) {
+	file, err := source.Open()
+	if err != nil {
+		return nil, "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	scanner := bufio.NewScanner(io.TeeReader(file, hash))
 
 	var lines []string
 	for scanner.Scan() {
@@ -45,8 +71,8 @@ func ReadInput(configuration config.Config, day string) ([]string, error) {
 	}
 
 	if scanner.Err() != nil {
-		return nil, scanner.Err()
+		return nil, "", scanner.Err()
 	}
 
-	return lines, nil
+	return lines, hex.EncodeToString(hash.Sum(nil)), nil
 }
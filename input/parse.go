@@ -0,0 +1,209 @@
+package input
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"bbuck.dev/aoc2025/grid"
+)
+
+// Sections splits the lines into the groups separated by blank lines,
+// yielding the zero based index of each group's first line along with it.
+// Runs of blank lines count as one separator and never produce empty
+// sections.
+func Sections(lines []string) iter.Seq2[int, []string] {
+	return func(yield func(int, []string) bool) {
+		start := 0
+		for i, line := range lines {
+			if line != "" {
+				continue
+			}
+
+			if i > start && !yield(start, lines[start:i]) {
+				return
+			}
+
+			start = i + 1
+		}
+
+		if start < len(lines) {
+			yield(start, lines[start:])
+		}
+	}
+}
+
+// Ints yields every integer found in the text. Anything other than a digit
+// separates integers and a minus sign directly in front of a digit makes it
+// negative, so "x=-3, y=14" yields -3 and 14. Integers too large for an int
+// yield a FieldError.
+func Ints(text string) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for i := 0; i < len(text); i++ {
+			if !isDigit(text[i]) {
+				continue
+			}
+
+			start := i
+			if start > 0 && text[start-1] == '-' {
+				start--
+			}
+
+			for i < len(text) && isDigit(text[i]) {
+				i++
+			}
+
+			if !yield(parseIntField(start, text[start:i])) {
+				return
+			}
+		}
+	}
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// IntFields splits the text on any of the separator runes and yields every
+// non-empty field as an integer, an empty separators string splits on white
+// space. Fields are trimmed of surrounding space and a field that is not an
+// integer yields a FieldError.
+func IntFields(text, separators string) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for offset, field := range Fields(text, separators) {
+			if !yield(parseIntField(offset, field)) {
+				return
+			}
+		}
+	}
+}
+
+// Fields splits the text on any of the separator runes, an empty separators
+// string splits on white space. Every non-empty field is yielded, trimmed of
+// surrounding space, with its byte offset in the text.
+func Fields(text, separators string) iter.Seq2[int, string] {
+	isSeparator := func(r rune) bool {
+		if separators == "" {
+			return unicode.IsSpace(r)
+		}
+
+		return strings.ContainsRune(separators, r)
+	}
+
+	return func(yield func(int, string) bool) {
+		rest, offset := text, 0
+		for {
+			end := strings.IndexFunc(rest, isSeparator)
+
+			field := rest
+			if end >= 0 {
+				field = rest[:end]
+			}
+
+			trimmed := strings.TrimLeftFunc(field, unicode.IsSpace)
+			fieldOffset := offset + len(field) - len(trimmed)
+			trimmed = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+
+			if trimmed != "" && !yield(fieldOffset, trimmed) {
+				return
+			}
+
+			if end < 0 {
+				return
+			}
+
+			_, width := utf8.DecodeRuneInString(rest[end:])
+			offset += end + width
+			rest = rest[end+width:]
+		}
+	}
+}
+
+func parseIntField(offset int, field string) (int, error) {
+	value, err := strconv.Atoi(field)
+	if err == nil {
+		return value, nil
+	}
+
+	cause := ErrNotInteger
+	if errors.Is(err, strconv.ErrRange) {
+		cause = fmt.Errorf("%w: out of range", ErrNotInteger)
+	}
+
+	return 0, &FieldError{
+		Offset: offset,
+		Field:  field,
+		Err:    cause,
+	}
+}
+
+// Collect gathers the values of the sequence, stopping at the first error.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var values []T
+	for value, err := range seq {
+		if err != nil {
+			return values, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// Cells yields the location and rune of every cell of the lines read as a
+// grid, in row then column order.
+func Cells(lines []string) iter.Seq2[grid.Location, rune] {
+	return func(yield func(grid.Location, rune) bool) {
+		for row, line := range lines {
+			for column, char := range []rune(line) {
+				if !yield(grid.NewLocation(row, column), char) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// LoadGrid converts the lines into a grid, using convert for every cell. All
+// lines must be the same length as the first, problems are reported as a
+// ParseError.
+func LoadGrid[T any](lines []string, convert func(rune) (T, error)) (*grid.Grid[T], error) {
+	if len(lines) == 0 {
+		return grid.NewGrid[T](0, 0), nil
+	}
+
+	columns := utf8.RuneCountInString(lines[0])
+	for row, line := range lines {
+		if length := utf8.RuneCountInString(line); length != columns {
+			return nil, LineError(row, line, fmt.Errorf("row has %d columns, expected %d", length, columns))
+		}
+	}
+
+	g := grid.NewGrid[T](len(lines), columns)
+	for row, line := range lines {
+		column := 0
+		for offset, char := range line {
+			value, err := convert(char)
+			if err != nil {
+				return nil, ColumnError(row, offset+1, line, err)
+			}
+
+			g.SetAt(grid.NewLocation(row, column), value)
+			column++
+		}
+	}
+
+	return g, nil
+}
+
+// RuneGrid loads the lines into a grid of their runes.
+func RuneGrid(lines []string) (*grid.Grid[rune], error) {
+	return LoadGrid(lines, func(r rune) (rune, error) {
+		return r, nil
+	})
+}
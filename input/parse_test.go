@@ -0,0 +1,241 @@
+package input
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"bbuck.dev/aoc2025/grid"
+)
+
+func TestSections(t *testing.T) {
+	type section struct {
+		start int
+		lines []string
+	}
+
+	tests := []struct {
+		name  string
+		lines []string
+		want  []section
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:  "single section",
+			lines: []string{"a", "b"},
+			want:  []section{{0, []string{"a", "b"}}},
+		},
+		{
+			name:  "two sections",
+			lines: []string{"a", "b", "", "c"},
+			want:  []section{{0, []string{"a", "b"}}, {3, []string{"c"}}},
+		},
+		{
+			name:  "runs of blank lines",
+			lines: []string{"", "a", "", "", "b", ""},
+			want:  []section{{1, []string{"a"}}, {4, []string{"b"}}},
+		},
+		{
+			name:  "only blank lines",
+			lines: []string{"", "", ""},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got []section
+			for start, lines := range Sections(test.lines) {
+				got = append(got, section{start, lines})
+			}
+
+			if !slices.EqualFunc(got, test.want, func(a, b section) bool {
+				return a.start == b.start && slices.Equal(a.lines, b.lines)
+			}) {
+				t.Errorf("Sections() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		text string
+		want []int
+	}{
+		{"", nil},
+		{"no numbers", nil},
+		{"1 2 3", []int{1, 2, 3}},
+		{"x=-3, y=14", []int{-3, 14}},
+		{"1-2", []int{1, -2}},
+		{"- 5", []int{5}},
+		{"--7", []int{-7}},
+		{"a12b34", []int{12, 34}},
+		{"-0", []int{0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			got, err := Collect(Ints(test.text))
+			if err != nil {
+				t.Fatalf("Ints(%q) error = %v", test.text, err)
+			}
+
+			if !slices.Equal(got, test.want) {
+				t.Errorf("Ints(%q) = %v, want %v", test.text, got, test.want)
+			}
+		})
+	}
+}
+
+func TestIntsOutOfRange(t *testing.T) {
+	_, err := Collect(Ints("ok 1 then 99999999999999999999"))
+
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || !errors.Is(err, ErrNotInteger) {
+		t.Fatalf("Ints() error = %v, want a FieldError wrapping ErrNotInteger", err)
+	}
+
+	if fieldErr.Offset != 10 {
+		t.Errorf("Offset = %d, want 10", fieldErr.Offset)
+	}
+}
+
+func TestFields(t *testing.T) {
+	type field struct {
+		offset int
+		text   string
+	}
+
+	tests := []struct {
+		name       string
+		text       string
+		separators string
+		want       []field
+	}{
+		{
+			name: "white space",
+			text: "  ab\tcd  e ",
+			want: []field{{2, "ab"}, {5, "cd"}, {9, "e"}},
+		},
+		{
+			name:       "commas with spaces",
+			text:       "1, 22 ,333",
+			separators: ",",
+			want:       []field{{0, "1"}, {3, "22"}, {7, "333"}},
+		},
+		{
+			name:       "empty fields are skipped",
+			text:       ",,a,,b,",
+			separators: ",",
+			want:       []field{{2, "a"}, {5, "b"}},
+		},
+		{
+			name:       "several separators",
+			text:       "1-2,3",
+			separators: "-,",
+			want:       []field{{0, "1"}, {2, "2"}, {4, "3"}},
+		},
+		{
+			name:       "multi-byte separator",
+			text:       "a→b",
+			separators: "→",
+			want:       []field{{0, "a"}, {4, "b"}},
+		},
+		{
+			name: "empty text",
+			text: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got []field
+			for offset, text := range Fields(test.text, test.separators) {
+				got = append(got, field{offset, text})
+			}
+
+			if !slices.Equal(got, test.want) {
+				t.Errorf("Fields(%q, %q) = %v, want %v", test.text, test.separators, got, test.want)
+			}
+		})
+	}
+}
+
+func TestIntFieldsError(t *testing.T) {
+	_, err := Collect(IntFields("1, x2, 3", ","))
+
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("IntFields() error = %v, want a FieldError", err)
+	}
+
+	if fieldErr.Offset != 3 || fieldErr.Field != "x2" {
+		t.Errorf("FieldError = offset %d field %q, want offset 3 field x2", fieldErr.Offset, fieldErr.Field)
+	}
+}
+
+func TestLoadGrid(t *testing.T) {
+	g, err := RuneGrid([]string{"ab", "cd", "ef"})
+	if err != nil {
+		t.Fatalf("RuneGrid() error = %v", err)
+	}
+
+	if g.RowLen() != 3 || g.ColumnLen() != 2 {
+		t.Errorf("grid is %dx%d, want 3x2", g.RowLen(), g.ColumnLen())
+	}
+
+	if cell, _ := g.At(grid.NewLocation(2, 1)); cell != 'f' {
+		t.Errorf("At(2, 1) = %q, want 'f'", cell)
+	}
+}
+
+func TestLoadGridErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		lines      []string
+		wantLine   int
+		wantColumn int
+	}{
+		{
+			name:     "short row",
+			lines:    []string{"...", "..", "..."},
+			wantLine: 2,
+		},
+		{
+			name:     "long row",
+			lines:    []string{"...", "...", "...."},
+			wantLine: 3,
+		},
+		{
+			name:       "bad cell",
+			lines:      []string{"...", ".x."},
+			wantLine:   2,
+			wantColumn: 2,
+		},
+	}
+
+	convert := func(r rune) (bool, error) {
+		if r != '.' && r != '#' {
+			return false, errors.New("expected . or #")
+		}
+
+		return r == '#', nil
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := LoadGrid(test.lines, convert)
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("LoadGrid() error = %v, want a ParseError", err)
+			}
+
+			if parseErr.Line != test.wantLine || parseErr.Column != test.wantColumn {
+				t.Errorf("error at %d:%d, want %d:%d", parseErr.Line, parseErr.Column, test.wantLine, test.wantColumn)
+			}
+		})
+	}
+}
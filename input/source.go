@@ -0,0 +1,229 @@
+package input
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"bbuck.dev/aoc2025/config"
+)
+
+// Source is a place puzzle input can be read from.
+type Source interface {
+	// Name describes the source for messages, usually the path that is read.
+	Name() string
+
+	// Open returns a reader over the input, the caller must close it.
+	Open() (io.ReadCloser, error)
+}
+
+// Stdin returns a source that reads the process's standard input.
+func Stdin() Source {
+	return stdinSource{}
+}
+
+type stdinSource struct{}
+
+func (stdinSource) Name() string {
+	return "<stdin>"
+}
+
+func (stdinSource) Open() (io.ReadCloser, error) {
+	return io.NopCloser(os.Stdin), nil
+}
+
+// File returns a source that reads the file at the given path, relative paths
+// are resolved against the working directory.
+func File(filePath string) Source {
+	return fileSource{filePath}
+}
+
+type fileSource struct {
+	path string
+}
+
+func (s fileSource) Name() string {
+	return s.path
+}
+
+func (s fileSource) Open() (io.ReadCloser, error) {
+	return os.Open(s.path)
+}
+
+// FS returns a source that reads the named file from the given file system,
+// such as an embed.FS or an os.DirFS.
+func FS(fsys fs.FS, name string) Source {
+	return fsSource{
+		fsys: fsys,
+		name: name,
+	}
+}
+
+type fsSource struct {
+	fsys fs.FS
+	name string
+}
+
+func (s fsSource) Name() string {
+	return s.name
+}
+
+func (s fsSource) Open() (io.ReadCloser, error) {
+	return s.fsys.Open(s.name)
+}
+
+// Variant returns a source for a named input of a day inside the inputs file
+// system, for example the "edge-case" variant of "2025/day7" is read from
+// "2025/day7/edge-case.in".
+func Variant(fsys fs.FS, day, variant string) (Source, error) {
+	if err := validVariant(variant); err != nil {
+		return nil, err
+	}
+
+	return FS(fsys, path.Join(day, variant+".in")), nil
+}
+
+func validVariant(variant string) error {
+	if variant == "" || strings.ContainsAny(variant, `/\`) {
+		return fmt.Errorf("invalid input variant %q", variant)
+	}
+
+	return nil
+}
+
+// SourceFor picks the source described by the configuration. An explicit
+// input path wins, "-" meaning standard input, otherwise the configured
+// variant of the day is read from the inputs file system.
+func SourceFor(configuration config.Config, day string) (Source, error) {
+	switch configuration.Input {
+	case "":
+	case "-":
+		return Stdin(), nil
+	default:
+		return File(configuration.Input), nil
+	}
+
+	if configuration.InputFS != nil {
+		return Variant(configuration.InputFS, DayDir(configuration, day), configuration.VariantName())
+	}
+
+	// read straight from disk so names in messages are real paths
+	if err := validVariant(configuration.VariantName()); err != nil {
+		return nil, err
+	}
+
+	return File(Path(configuration, day, configuration.VariantName())), nil
+}
+
+// Variants lists the names of every input variant stored for a day, sorted
+// by name. It reads InputFS when set and InputDir otherwise.
+func Variants(configuration config.Config, day string) ([]string, error) {
+	fsys := configuration.InputFS
+	if fsys == nil {
+		fsys = os.DirFS(configuration.InputDir)
+	}
+
+	matches, err := fs.Glob(fsys, path.Join(DayDir(configuration, day), "*.in"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no inputs found for %s", day)
+	}
+
+	variants := make([]string, 0, len(matches))
+	for _, match := range matches {
+		variants = append(variants, strings.TrimSuffix(path.Base(match), ".in"))
+	}
+
+	return variants, nil
+}
+
+// answerSourcer is implemented by sources that can have expected answers
+// stored beside them.
+type answerSourcer interface {
+	answerSource(part int) Source
+}
+
+func (s fileSource) answerSource(part int) Source {
+	return File(answerName(s.path, filepath.Ext(s.path), part))
+}
+
+func (s fsSource) answerSource(part int) Source {
+	return FS(s.fsys, answerName(s.name, path.Ext(s.name), part))
+}
+
+// answerName turns an input name like "2025/day5/sample.in" into the name of
+// the expected answer for the part, like "2025/day5/sample.part2.out".
+func answerName(name, extension string, part int) string {
+	return fmt.Sprintf("%s.part%d.out", strings.TrimSuffix(name, extension), part)
+}
+
+// ExpectedAnswer reads the answer stored beside the source for the given
+// part. The returned bool is false when no answer has been stored, which is
+// always the case for standard input. An empty answer file is a placeholder
+// and counts as no answer.
+func ExpectedAnswer(source Source, part int) (string, bool, error) {
+	sourcer, ok := source.(answerSourcer)
+	if !ok {
+		return "", false, nil
+	}
+
+	file, err := sourcer.answerSource(part).Open()
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", false, nil
+	}
+
+	if err != nil {
+		return "", false, err
+	}
+	defer file.Close()
+
+	contents, err := io.ReadAll(file)
+	if err != nil {
+		return "", false, err
+	}
+
+	answer := strings.TrimSpace(string(contents))
+
+	return answer, answer != "", nil
+}
+
+// Path returns the path on disk of a day's input variant inside the
+// configured input directory, the file that Variant reads when InputFS is
+// not set.
+func Path(configuration config.Config, day, variant string) string {
+	return filepath.Join(configuration.InputDir, filepath.FromSlash(DayDir(configuration, day)), variant+".in")
+}
+
+// DayDir returns the directory inside the inputs holding the day's inputs.
+// Inputs of the default year were stored as "day5" before years were added,
+// that directory is still read when the day has no "2025/day5" directory.
+func DayDir(configuration config.Config, day string) string {
+	if configuration.Year != config.DefaultYear || day != configuration.DayPath() {
+		return day
+	}
+
+	fsys := configuration.InputFS
+	if fsys == nil {
+		fsys = os.DirFS(configuration.InputDir)
+	}
+
+	if isDir(fsys, day) || !isDir(fsys, configuration.DayName()) {
+		return day
+	}
+
+	return configuration.DayName()
+}
+
+func isDir(fsys fs.FS, name string) bool {
+	info, err := fs.Stat(fsys, name)
+
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,234 @@
+package input
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"bbuck.dev/aoc2025/config"
+)
+
+var inputs = fstest.MapFS{
+	"2025/day5/sample.in":           {Data: []byte("1\n2\n")},
+	"2025/day5/sample.part1.out":    {Data: []byte("3\n")},
+	"2025/day5/problem.in":          {Data: []byte("4\n")},
+	"2025/day5/problem.part1.out":   {Data: []byte("\n")},
+	"2025/day5/edge-case.in":        {Data: []byte("5\n")},
+	"2025/day5/edge-case.part2.out": {Data: []byte(" 10 \n")},
+	"2025/day5/notes.txt":           {Data: []byte("not an input\n")},
+}
+
+func TestSourceFor(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name          string
+		configuration config.Config
+		want          string
+	}{
+		{
+			name:          "explicit path",
+			configuration: config.Config{Input: "elsewhere/day5.txt", InputFS: inputs},
+			want:          "elsewhere/day5.txt",
+		},
+		{
+			name:          "stdin",
+			configuration: config.Config{Input: "-", InputFS: inputs},
+			want:          "<stdin>",
+		},
+		{
+			name:          "sample by default",
+			configuration: config.Config{InputFS: inputs},
+			want:          "2025/day5/sample.in",
+		},
+		{
+			name:          "problem when solving",
+			configuration: config.Config{Solve: true, InputFS: inputs},
+			want:          "2025/day5/problem.in",
+		},
+		{
+			name:          "named variant",
+			configuration: config.Config{Variant: "edge-case", Solve: true, InputFS: inputs},
+			want:          "2025/day5/edge-case.in",
+		},
+		{
+			name:          "disk",
+			configuration: config.Config{InputDir: dir},
+			want:          filepath.Join(dir, "2025", "day5", "sample.in"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source, err := SourceFor(test.configuration, "2025/day5")
+			if err != nil {
+				t.Fatalf("SourceFor() error = %v", err)
+			}
+
+			if name := source.Name(); name != test.want {
+				t.Errorf("Name() = %q, want %q", name, test.want)
+			}
+		})
+	}
+}
+
+func TestSourceForRejectsVariantPaths(t *testing.T) {
+	for _, variant := range []string{"a/b", `a\b`, "../problem"} {
+		for _, configuration := range []config.Config{
+			{Variant: variant, InputFS: inputs},
+			{Variant: variant, InputDir: t.TempDir()},
+		} {
+			if _, err := SourceFor(configuration, "2025/day5"); err == nil {
+				t.Errorf("SourceFor() with variant %q did not fail", variant)
+			}
+		}
+	}
+
+	if err := validVariant(""); err == nil {
+		t.Error("validVariant(\"\") did not fail")
+	}
+}
+
+func TestSourceForReadsDisk(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "2025", "day5"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "2025", "day5", "sample.in"), []byte("7\n8\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := SourceFor(config.Config{InputDir: dir}, "2025/day5")
+	if err != nil {
+		t.Fatalf("SourceFor() error = %v", err)
+	}
+
+	lines, err := ReadSource(source)
+	if err != nil {
+		t.Fatalf("ReadSource() error = %v", err)
+	}
+
+	if want := []string{"7", "8"}; !slices.Equal(lines, want) {
+		t.Errorf("ReadSource() = %q, want %q", lines, want)
+	}
+}
+
+func TestVariants(t *testing.T) {
+	variants, err := Variants(config.Config{InputFS: inputs}, "2025/day5")
+	if err != nil {
+		t.Fatalf("Variants() error = %v", err)
+	}
+
+	if want := []string{"edge-case", "problem", "sample"}; !slices.Equal(variants, want) {
+		t.Errorf("Variants() = %q, want %q", variants, want)
+	}
+
+	if _, err := Variants(config.Config{InputFS: inputs}, "2025/day6"); err == nil {
+		t.Error("Variants() for a day without inputs did not fail")
+	}
+
+	if _, err := Variants(config.Config{InputDir: t.TempDir()}, "2025/day5"); err == nil {
+		t.Error("Variants() for an empty input directory did not fail")
+	}
+}
+
+func TestExpectedAnswer(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "day5.txt"), []byte("1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "day5.part2.out"), []byte("42\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		source Source
+		part   int
+		want   string
+		found  bool
+	}{
+		{"stored", FS(inputs, "2025/day5/sample.in"), 1, "3", true},
+		{"missing", FS(inputs, "2025/day5/sample.in"), 2, "", false},
+		{"empty placeholder", FS(inputs, "2025/day5/problem.in"), 1, "", false},
+		{"trimmed", FS(inputs, "2025/day5/edge-case.in"), 2, "10", true},
+		{"beside a file", File(filepath.Join(dir, "day5.txt")), 2, "42", true},
+		{"stdin", Stdin(), 1, "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			answer, found, err := ExpectedAnswer(test.source, test.part)
+			if err != nil {
+				t.Fatalf("ExpectedAnswer() error = %v", err)
+			}
+
+			if answer != test.want || found != test.found {
+				t.Errorf("ExpectedAnswer() = %q, %t, want %q, %t", answer, found, test.want, test.found)
+			}
+		})
+	}
+}
+
+func TestDayDirFallsBackToUnqualifiedDays(t *testing.T) {
+	legacy := fstest.MapFS{
+		"day3/sample.in":      {Data: []byte("1\n")},
+		"2025/day5/sample.in": {Data: []byte("2\n")},
+		"day5/sample.in":      {Data: []byte("3\n")},
+		"day7/sample.in":      {Data: []byte("4\n")},
+	}
+
+	tests := []struct {
+		name          string
+		configuration config.Config
+		want          string
+	}{
+		{"old layout", config.Config{Year: 2025, Day: 3}, "day3"},
+		{"new layout wins", config.Config{Year: 2025, Day: 5}, "2025/day5"},
+		{"no inputs", config.Config{Year: 2025, Day: 6}, "2025/day6"},
+		{"other years", config.Config{Year: 2024, Day: 7}, "2024/day7"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.configuration.InputFS = legacy
+			if dir := DayDir(test.configuration, test.configuration.DayPath()); dir != test.want {
+				t.Errorf("DayDir() = %q, want %q", dir, test.want)
+			}
+		})
+	}
+
+	configuration := config.Config{Year: 2025, Day: 3, InputFS: legacy}
+
+	source, err := SourceFor(configuration, configuration.DayPath())
+	if err != nil {
+		t.Fatalf("SourceFor() error = %v", err)
+	}
+
+	if name := source.Name(); name != "day3/sample.in" {
+		t.Errorf("SourceFor() read %q, want day3/sample.in", name)
+	}
+
+	variants, err := Variants(configuration, configuration.DayPath())
+	if err != nil {
+		t.Fatalf("Variants() error = %v", err)
+	}
+
+	if want := []string{"sample"}; !slices.Equal(variants, want) {
+		t.Errorf("Variants() = %q, want %q", variants, want)
+	}
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "day3"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	disk := config.Config{Year: 2025, Day: 3, InputDir: dir}
+	if path, want := Path(disk, disk.DayPath(), "sample"), filepath.Join(dir, "day3", "sample.in"); path != want {
+		t.Errorf("Path() = %q, want %q", path, want)
+	}
+}
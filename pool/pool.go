@@ -0,0 +1,162 @@
+// Package pool runs tasks over a slice of items on a bounded number of
+// goroutines.
+package pool
+
+import (
+	"fmt"
+	"iter"
+	"runtime"
+	"runtime/debug"
+	"sync"
+)
+
+// PanicError is returned in place of a task's result when the task panicked.
+// The message only holds the panic value, the stack is kept in Stack.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("task panicked: %v", e.Value)
+}
+
+// Unwrap returns the panic value when it was an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+
+	return err
+}
+
+// Limit returns the number of goroutines used for the given limit, values
+// below one mean one goroutine per available CPU.
+func Limit(limit int) int {
+	if limit < 1 {
+		return runtime.GOMAXPROCS(0)
+	}
+
+	return limit
+}
+
+// run calls the task, turning a panic into a *PanicError.
+func run[T, R any](task func(T) (R, error), item T) (result R, err error) {
+	defer func() {
+		if value := recover(); value != nil {
+			err = &PanicError{
+				Value: value,
+				Stack: debug.Stack(),
+			}
+		}
+	}()
+
+	return task(item)
+}
+
+// Map runs the task for every item with at most limit tasks running at once
+// and returns the results in the order of the items. The first error, or
+// panic, stops any tasks that have not started yet and is returned.
+func Map[T, R any](limit int, items []T, task func(T) (R, error)) ([]R, error) {
+	var (
+		results = make([]R, len(items))
+		failure error
+		once    sync.Once
+		done    = make(chan struct{})
+		indexes = make(chan int)
+		wg      sync.WaitGroup
+	)
+
+	for range min(Limit(limit), len(items)) {
+		wg.Go(func() {
+			for i := range indexes {
+				result, err := run(task, items[i])
+				if err != nil {
+					once.Do(func() {
+						failure = err
+						close(done)
+					})
+
+					continue
+				}
+
+				results[i] = result
+			}
+		})
+	}
+
+feed:
+	for i := range items {
+		select {
+		case indexes <- i:
+		case <-done:
+			break feed
+		}
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	if failure != nil {
+		return nil, failure
+	}
+
+	return results, nil
+}
+
+// Unordered runs the task for every item with at most limit tasks running at
+// once, yielding each result as soon as its task finishes. A failed task
+// yields its error and the iteration carries on, stopping the iteration
+// early cancels the tasks that have not started yet.
+func Unordered[T, R any](limit int, items []T, task func(T) (R, error)) iter.Seq2[R, error] {
+	type outcome struct {
+		result R
+		err    error
+	}
+
+	return func(yield func(R, error) bool) {
+		var (
+			done     = make(chan struct{})
+			indexes  = make(chan int)
+			outcomes = make(chan outcome)
+			wg       sync.WaitGroup
+		)
+
+		for range min(Limit(limit), len(items)) {
+			wg.Go(func() {
+				for i := range indexes {
+					result, err := run(task, items[i])
+
+					select {
+					case outcomes <- outcome{result, err}:
+					case <-done:
+						return
+					}
+				}
+			})
+		}
+
+		go func() {
+			defer close(indexes)
+
+			for i := range items {
+				select {
+				case indexes <- i:
+				case <-done:
+					return
+				}
+			}
+		}()
+
+		go func() {
+			wg.Wait()
+			close(outcomes)
+		}()
+
+		defer close(done)
+
+		for outcome := range outcomes {
+			if !yield(outcome.result, outcome.err) {
+				return
+			}
+		}
+	}
+}
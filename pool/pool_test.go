@@ -0,0 +1,110 @@
+package pool
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMapKeepsOrder(t *testing.T) {
+	items := []int{5, 1, 4, 2, 3, 0}
+
+	results, err := Map(3, items, func(item int) (int, error) {
+		// later items finish first so completion order differs from input order
+		time.Sleep(time.Duration(item) * time.Millisecond)
+
+		return item * 10, nil
+	})
+	if err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+
+	if want := []int{50, 10, 40, 20, 30, 0}; !slices.Equal(results, want) {
+		t.Errorf("Map() = %v, want %v", results, want)
+	}
+}
+
+func TestMapStopsOnError(t *testing.T) {
+	var (
+		items = make([]int, 100)
+		calls atomic.Int32
+		fail  = errors.New("fail")
+	)
+
+	for i := range items {
+		items[i] = i
+	}
+
+	results, err := Map(1, items, func(item int) (int, error) {
+		calls.Add(1)
+		if item == 3 {
+			return 0, fail
+		}
+
+		return item, nil
+	})
+	if !errors.Is(err, fail) {
+		t.Fatalf("Map() error = %v, want %v", err, fail)
+	}
+
+	if results != nil {
+		t.Errorf("Map() results = %v, want nil on error", results)
+	}
+
+	if count := calls.Load(); count >= int32(len(items)) {
+		t.Errorf("task ran %d times, want the error to stop the remaining items", count)
+	}
+}
+
+func TestMapRecoversPanics(t *testing.T) {
+	_, err := Map(2, []int{1, 2, 3}, func(item int) (int, error) {
+		if item == 2 {
+			panic("boom")
+		}
+
+		return item, nil
+	})
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("Map() error = %v, want a *PanicError", err)
+	}
+
+	if got := panicErr.Error(); got != "task panicked: boom" {
+		t.Errorf("Error() = %q, want %q", got, "task panicked: boom")
+	}
+
+	if !strings.Contains(string(panicErr.Stack), "TestMapRecoversPanics") {
+		t.Errorf("Stack does not include the panicking task:\n%s", panicErr.Stack)
+	}
+}
+
+func TestPanicErrorUnwrap(t *testing.T) {
+	cause := errors.New("cause")
+	err := &PanicError{Value: cause}
+
+	if !errors.Is(err, cause) {
+		t.Errorf("errors.Is(%v, cause) = false, want true", err)
+	}
+}
+
+func TestUnorderedYieldsEveryResult(t *testing.T) {
+	var results []int
+	for result, err := range Unordered(4, []int{1, 2, 3, 4, 5}, func(item int) (int, error) {
+		return item * item, nil
+	}) {
+		if err != nil {
+			t.Fatalf("Unordered() error = %v", err)
+		}
+
+		results = append(results, result)
+	}
+
+	slices.Sort(results)
+	if want := []int{1, 4, 9, 16, 25}; !slices.Equal(results, want) {
+		t.Errorf("Unordered() = %v, want %v", results, want)
+	}
+}
@@ -0,0 +1,192 @@
+// Package progress shows how far long running solvers have got. Solvers
+// report units of work to a Tracker taken from their context, which costs a
+// single atomic add, while the display is redrawn in the background.
+package progress
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	barWidth = 30
+
+	// redraws of the bar on a terminal and log lines elsewhere are spaced
+	// out so that short solves print nothing at all
+	terminalInterval = 100 * time.Millisecond
+	logInterval      = 5 * time.Second
+)
+
+// Display is where progress is shown, a terminal gets a redrawn bar and
+// anything else periodic log lines.
+type Display struct {
+	w        io.Writer
+	terminal bool
+	interval time.Duration
+}
+
+// NewDisplay creates a display writing to the file, usually os.Stderr.
+func NewDisplay(file *os.File) *Display {
+	var terminal bool
+	if info, err := file.Stat(); err == nil {
+		terminal = info.Mode()&os.ModeCharDevice != 0
+	}
+
+	display := &Display{
+		w:        file,
+		terminal: terminal,
+		interval: logInterval,
+	}
+
+	if terminal {
+		display.interval = terminalInterval
+	}
+
+	return display
+}
+
+type contextKey struct{}
+
+// NewContext returns a context that solvers can start trackers from, shown
+// on the display.
+func NewContext(ctx context.Context, display *Display) context.Context {
+	return context.WithValue(ctx, contextKey{}, display)
+}
+
+// Tracker counts the units of work done on a task. A nil Tracker is valid
+// and ignores everything, so solvers need not check whether progress is
+// being shown.
+type Tracker struct {
+	display *Display
+	name    string
+	total   int64
+	start   time.Time
+	done    atomic.Int64
+
+	stop     chan struct{}
+	finished chan struct{}
+}
+
+// Start begins tracking a task of total units, zero when the total is not
+// known. It returns nil when the context has no display. The tracker must be
+// finished once the task is over.
+func Start(ctx context.Context, name string, total int) *Tracker {
+	display, ok := ctx.Value(contextKey{}).(*Display)
+	if !ok {
+		return nil
+	}
+
+	tracker := &Tracker{
+		display:  display,
+		name:     name,
+		total:    int64(total),
+		start:    time.Now(),
+		stop:     make(chan struct{}),
+		finished: make(chan struct{}),
+	}
+
+	go tracker.render()
+
+	return tracker
+}
+
+// Add records n more units of work as done.
+func (t *Tracker) Add(n int) {
+	if t == nil {
+		return
+	}
+
+	t.done.Add(int64(n))
+}
+
+// Increment records one more unit of work as done.
+func (t *Tracker) Increment() {
+	t.Add(1)
+}
+
+// Finish stops showing the task, leaving its final state on the display if
+// anything was shown for it.
+func (t *Tracker) Finish() {
+	if t == nil {
+		return
+	}
+
+	close(t.stop)
+	<-t.finished
+}
+
+func (t *Tracker) render() {
+	defer close(t.finished)
+
+	var (
+		ticker = time.NewTicker(t.display.interval)
+		shown  bool
+	)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			t.show()
+			shown = true
+
+		case <-t.stop:
+			if shown {
+				t.show()
+				if t.display.terminal {
+					fmt.Fprintln(t.display.w)
+				}
+			}
+
+			return
+		}
+	}
+}
+
+// show draws the current state, over the previous one on a terminal.
+func (t *Tracker) show() {
+	var (
+		done    = t.done.Load()
+		elapsed = time.Since(t.start)
+		rate    = float64(done) / elapsed.Seconds()
+		builder = new(strings.Builder)
+	)
+
+	if t.display.terminal {
+		builder.WriteString("\r\x1b[K")
+	}
+
+	builder.WriteString(t.name)
+	builder.WriteString(": ")
+
+	if t.total > 0 {
+		if t.display.terminal {
+			filled := int(min(done, t.total) * barWidth / t.total)
+			fmt.Fprintf(builder, "[%s%s] ", strings.Repeat("=", filled), strings.Repeat(" ", barWidth-filled))
+		}
+
+		fmt.Fprintf(builder, "%d/%d (%.1f%%)", done, t.total, float64(done)*100/float64(t.total))
+	} else {
+		fmt.Fprintf(builder, "%d", done)
+	}
+
+	fmt.Fprintf(builder, ", %.1f/s", rate)
+
+	if t.total > 0 && done > 0 && done < t.total {
+		remaining := time.Duration(float64(t.total-done) / rate * float64(time.Second))
+		fmt.Fprintf(builder, ", ETA %s", remaining.Round(time.Second))
+	} else {
+		fmt.Fprintf(builder, ", %s elapsed", elapsed.Round(time.Second))
+	}
+
+	if !t.display.terminal {
+		builder.WriteRune('\n')
+	}
+
+	io.WriteString(t.display.w, builder.String())
+}
@@ -0,0 +1,138 @@
+package runner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"bbuck.dev/aoc2025/atomicfile"
+)
+
+// Baseline is the stored set of benchmarks later runs are compared with.
+type Baseline struct {
+	path       string
+	Benchmarks map[string]Benchmark `json:"benchmarks"`
+}
+
+// OpenBaseline loads the baseline stored at the given path, a missing file is
+// an empty baseline.
+func OpenBaseline(path string) (*Baseline, error) {
+	baseline := &Baseline{
+		path:       path,
+		Benchmarks: make(map[string]Benchmark),
+	}
+
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return baseline, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+
+	if err := json.Unmarshal(contents, baseline); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline %s: %w", path, err)
+	}
+
+	if baseline.Benchmarks == nil {
+		baseline.Benchmarks = make(map[string]Benchmark)
+	}
+
+	return baseline, nil
+}
+
+// Save writes the baseline back to the path it was opened from.
+func (b *Baseline) Save() error {
+	contents, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return atomicfile.Write(b.path, append(contents, '\n'))
+}
+
+func baselineKey(benchmark Benchmark) string {
+	return fmt.Sprintf("%d/day%d/part%d/%s", benchmark.Year, benchmark.Day, benchmark.Part, benchmark.Input)
+}
+
+// Lookup returns the stored benchmark matching the year, day, part and input
+// of the given one.
+func (b *Baseline) Lookup(benchmark Benchmark) (Benchmark, bool) {
+	stored, ok := b.Benchmarks[baselineKey(benchmark)]
+
+	return stored, ok
+}
+
+// Store records the benchmark, replacing any stored for the same year, day,
+// part and input.
+func (b *Baseline) Store(benchmark Benchmark) {
+	b.Benchmarks[baselineKey(benchmark)] = benchmark
+}
+
+// Comparison is a benchmark measured against its stored baseline.
+type Comparison struct {
+	Benchmark
+
+	// Baseline is nil when no benchmark was stored for the part.
+	Baseline *Benchmark `json:"baseline,omitempty"`
+
+	// ParseChange and SolveChange are the relative changes of the medians,
+	// see Stats.Change.
+	ParseChange float64 `json:"parse_change"`
+	SolveChange float64 `json:"solve_change"`
+	Regressed   bool    `json:"regressed"`
+}
+
+// Compare measures the benchmark against the baseline. It has regressed when
+// either median is slower than the baseline's by more than the threshold,
+// 0.1 allowing ten percent.
+func (b *Baseline) Compare(benchmark Benchmark, threshold float64) Comparison {
+	comparison := Comparison{Benchmark: benchmark}
+
+	stored, ok := b.Lookup(benchmark)
+	if !ok {
+		return comparison
+	}
+
+	comparison.Baseline = &stored
+	comparison.ParseChange = benchmark.Parse.Change(stored.Parse)
+	comparison.SolveChange = benchmark.Solve.Change(stored.Solve)
+	comparison.Regressed = comparison.ParseChange > threshold || comparison.SolveChange > threshold
+
+	return comparison
+}
+
+// Track compares the benchmark against the baseline like Compare and stores
+// it when the part had no baseline yet, or when update is set. Later runs are
+// otherwise never stored so regressions are not silently accepted. The
+// returned bool reports whether the benchmark was stored.
+func (b *Baseline) Track(benchmark Benchmark, threshold float64, update bool) (Comparison, bool) {
+	comparison := b.Compare(benchmark, threshold)
+	if comparison.Baseline != nil && !update {
+		return comparison, false
+	}
+
+	b.Store(benchmark)
+
+	return comparison, true
+}
+
+func (c Comparison) String() string {
+	if c.Baseline == nil {
+		return fmt.Sprintf("%s\n  parse: %s\n  solve: %s", c.Benchmark, c.Parse, c.Solve)
+	}
+
+	label := c.Benchmark.String()
+	if c.Regressed {
+		label = "REGRESSION " + label
+	}
+
+	return fmt.Sprintf(
+		"%s\n  parse: %s (baseline %s, %+.1f%%)\n  solve: %s (baseline %s, %+.1f%%)",
+		label,
+		c.Parse, c.Baseline.Parse.Median, c.ParseChange*100,
+		c.Solve, c.Baseline.Solve.Median, c.SolveChange*100,
+	)
+}
@@ -0,0 +1,112 @@
+package runner
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func benchmarkWith(parse, solve time.Duration) Benchmark {
+	return Benchmark{
+		Year:  2025,
+		Day:   1,
+		Part:  1,
+		Input: "problem",
+		Runs:  10,
+		Parse: Stats{Median: parse},
+		Solve: Stats{Median: solve},
+	}
+}
+
+func TestBaselineCompare(t *testing.T) {
+	tests := []struct {
+		name         string
+		parse, solve time.Duration
+		regressed    bool
+	}{
+		{"same", 100, 1000, false},
+		{"faster", 50, 500, false},
+		{"solve at the threshold", 100, 1100, false},
+		{"solve over the threshold", 100, 1101, true},
+		{"parse over the threshold", 111, 1000, true},
+	}
+
+	baseline, err := OpenBaseline(filepath.Join(t.TempDir(), "bench.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseline.Store(benchmarkWith(100, 1000))
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			comparison := baseline.Compare(benchmarkWith(test.parse, test.solve), 0.1)
+			if comparison.Baseline == nil {
+				t.Fatal("Compare() found no baseline")
+			}
+
+			if comparison.Regressed != test.regressed {
+				t.Errorf("Compare() regressed = %v, want %v (parse %+.3f, solve %+.3f)",
+					comparison.Regressed, test.regressed, comparison.ParseChange, comparison.SolveChange)
+			}
+		})
+	}
+}
+
+func TestBaselineCompareOtherInput(t *testing.T) {
+	baseline, err := OpenBaseline(filepath.Join(t.TempDir(), "bench.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseline.Store(benchmarkWith(100, 100))
+
+	other := benchmarkWith(1000, 1000)
+	other.Input = "sample"
+
+	comparison := baseline.Compare(other, 0.1)
+	if comparison.Baseline != nil || comparison.Regressed {
+		t.Errorf("Compare() for another input = %+v, want no baseline and no regression", comparison)
+	}
+}
+
+func TestBaselineTrack(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bench.json")
+	baseline, err := OpenBaseline(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the first run of a part becomes its baseline
+	if _, stored := baseline.Track(benchmarkWith(100, 100), 0.1, false); !stored {
+		t.Error("Track() did not store the first run")
+	}
+
+	// a slower run is reported but does not replace the baseline
+	comparison, stored := baseline.Track(benchmarkWith(100, 200), 0.1, false)
+	if stored || !comparison.Regressed {
+		t.Errorf("Track() of a regression = stored %v regressed %v, want not stored and regressed", stored, comparison.Regressed)
+	}
+
+	if current, _ := baseline.Lookup(benchmarkWith(0, 0)); current.Solve.Median != 100 {
+		t.Errorf("baseline solve median = %s, want it left at 100ns", current.Solve.Median)
+	}
+
+	// unless an update is asked for
+	if _, stored := baseline.Track(benchmarkWith(100, 200), 0.1, true); !stored {
+		t.Error("Track() with update did not store the run")
+	}
+
+	if err := baseline.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	reopened, err := OpenBaseline(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if current, ok := reopened.Lookup(benchmarkWith(0, 0)); !ok || current.Solve.Median != 200 {
+		t.Errorf("saved baseline = %+v, %v, want the updated run", current, ok)
+	}
+}
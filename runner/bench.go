@@ -0,0 +1,196 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"time"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/solver"
+)
+
+// Stats summarises repeated measurements of one phase of solving a part.
+type Stats struct {
+	Min    time.Duration `json:"min_ns"`
+	Median time.Duration `json:"median_ns"`
+	P95    time.Duration `json:"p95_ns"`
+
+	// Allocs and Bytes are the average heap allocations made by one run.
+	Allocs uint64 `json:"allocs"`
+	Bytes  uint64 `json:"bytes"`
+}
+
+// Change returns how much slower the median is than the baseline's median,
+// 0.1 meaning ten percent slower and negative values meaning faster.
+func (s Stats) Change(baseline Stats) float64 {
+	if baseline.Median == 0 {
+		return 0
+	}
+
+	return float64(s.Median-baseline.Median) / float64(baseline.Median)
+}
+
+func (s Stats) String() string {
+	return fmt.Sprintf("min %s, median %s, p95 %s, %d allocs (%d B)", s.Min, s.Median, s.P95, s.Allocs, s.Bytes)
+}
+
+// Benchmark is the result of parsing and solving a part repeatedly.
+type Benchmark struct {
+	Year  int    `json:"year"`
+	Day   int    `json:"day"`
+	Part  int    `json:"part"`
+	Input string `json:"input"`
+	Runs  int    `json:"runs"`
+	Parse Stats  `json:"parse"`
+	Solve Stats  `json:"solve"`
+}
+
+func (b Benchmark) String() string {
+	return fmt.Sprintf("%d day %d part %d (%s), %d runs", b.Year, b.Day, b.Part, b.Input, b.Runs)
+}
+
+// measurement is a single timed run of a phase.
+type measurement struct {
+	elapsed time.Duration
+	allocs  uint64
+	bytes   uint64
+}
+
+// measure times the function and counts the heap allocations it made.
+func measure(run func() error) (measurement, error) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	start := time.Now()
+	err := run()
+	elapsed := time.Since(start)
+
+	runtime.ReadMemStats(&after)
+
+	return measurement{
+		elapsed: elapsed,
+		allocs:  after.Mallocs - before.Mallocs,
+		bytes:   after.TotalAlloc - before.TotalAlloc,
+	}, err
+}
+
+// summarise reduces the measurements of a phase to its statistics.
+func summarise(measurements []measurement) Stats {
+	var (
+		durations = make([]time.Duration, 0, len(measurements))
+		allocs    uint64
+		bytes     uint64
+	)
+	for _, m := range measurements {
+		durations = append(durations, m.elapsed)
+		allocs += m.allocs
+		bytes += m.bytes
+	}
+
+	slices.Sort(durations)
+
+	runs := uint64(len(measurements))
+
+	return Stats{
+		Min:    durations[0],
+		Median: durations[len(durations)/2],
+		P95:    durations[(len(durations)*95+99)/100-1],
+		Allocs: allocs / runs,
+		Bytes:  bytes / runs,
+	}
+}
+
+// Bench parses and solves each configured part of the day the given number
+// of times, with a fresh solver for every run. The input is read once. Parts
+// that are not implemented are left out, and with AllInputs every input of
+// the day is benchmarked.
+func Bench(ctx context.Context, configuration config.Config, runs int) ([]Benchmark, error) {
+	if runs < 1 {
+		return nil, errors.New("at least one run is required")
+	}
+
+	factory, err := solver.Lookup(configuration.Year, configuration.Day)
+	if err != nil {
+		return nil, err
+	}
+
+	configurations, err := Inputs(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	var benchmarks []Benchmark
+	for _, inputConfiguration := range configurations {
+		if err := solver.CheckParams(inputConfiguration); err != nil {
+			return benchmarks, err
+		}
+
+		source, err := input.SourceFor(inputConfiguration, inputConfiguration.DayPath())
+		if err != nil {
+			return benchmarks, fmt.Errorf("failed to read input: %w", err)
+		}
+
+		lines, err := input.ReadSource(source)
+		if err != nil {
+			return benchmarks, fmt.Errorf("failed to read input: %w", err)
+		}
+
+		for _, part := range inputConfiguration.Part.Parts() {
+			benchmark, err := benchPart(ctx, inputConfiguration, factory, lines, part, runs)
+			if errors.Is(err, solver.ErrNotImplemented) {
+				continue
+			}
+
+			if err != nil {
+				return benchmarks, fmt.Errorf("failed to solve part %d: %w", part, err)
+			}
+
+			benchmarks = append(benchmarks, benchmark)
+		}
+	}
+
+	return benchmarks, nil
+}
+
+func benchPart(ctx context.Context, configuration config.Config, factory solver.Factory, lines []string, part, runs int) (Benchmark, error) {
+	var (
+		parses = make([]measurement, 0, runs)
+		solves = make([]measurement, 0, runs)
+	)
+	for range runs {
+		daySolver := factory(configuration)
+
+		parse, err := measure(func() error {
+			return daySolver.Parse(lines)
+		})
+		if err != nil {
+			return Benchmark{}, fmt.Errorf("failed to parse input: %w", err)
+		}
+
+		solve, err := measure(func() error {
+			_, err := solvePart(ctx, configuration, daySolver, part)
+
+			return err
+		})
+		if err != nil {
+			return Benchmark{}, err
+		}
+
+		parses = append(parses, parse)
+		solves = append(solves, solve)
+	}
+
+	return Benchmark{
+		Year:  configuration.Year,
+		Day:   configuration.Day,
+		Part:  part,
+		Input: configuration.InputName(),
+		Runs:  runs,
+		Parse: summarise(parses),
+		Solve: summarise(solves),
+	}, nil
+}
@@ -0,0 +1,80 @@
+package runner
+
+import (
+	"testing"
+	"time"
+)
+
+// runsOf builds measurements taking 1ms, 2ms and so on up to n ms, given in
+// reverse so summarise has to sort them.
+func runsOf(n int) []measurement {
+	measurements := make([]measurement, 0, n)
+	for i := n; i >= 1; i-- {
+		measurements = append(measurements, measurement{elapsed: time.Duration(i) * time.Millisecond})
+	}
+
+	return measurements
+}
+
+func TestSummarise(t *testing.T) {
+	tests := []struct {
+		name   string
+		runs   []measurement
+		min    time.Duration
+		median time.Duration
+		p95    time.Duration
+	}{
+		{"one run", runsOf(1), time.Millisecond, time.Millisecond, time.Millisecond},
+		{"two runs", runsOf(2), time.Millisecond, 2 * time.Millisecond, 2 * time.Millisecond},
+		{"odd runs", runsOf(5), time.Millisecond, 3 * time.Millisecond, 5 * time.Millisecond},
+		{"ten runs", runsOf(10), time.Millisecond, 6 * time.Millisecond, 10 * time.Millisecond},
+		{"twenty runs", runsOf(20), time.Millisecond, 11 * time.Millisecond, 19 * time.Millisecond},
+		{"hundred runs", runsOf(100), time.Millisecond, 51 * time.Millisecond, 95 * time.Millisecond},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stats := summarise(test.runs)
+			if stats.Min != test.min || stats.Median != test.median || stats.P95 != test.p95 {
+				t.Errorf("summarise() = min %s median %s p95 %s, want min %s median %s p95 %s",
+					stats.Min, stats.Median, stats.P95, test.min, test.median, test.p95)
+			}
+		})
+	}
+}
+
+func TestSummariseAveragesAllocations(t *testing.T) {
+	stats := summarise([]measurement{
+		{elapsed: time.Millisecond, allocs: 10, bytes: 1000},
+		{elapsed: time.Millisecond, allocs: 20, bytes: 2000},
+		{elapsed: time.Millisecond, allocs: 31, bytes: 3001},
+	})
+
+	// averages are rounded down
+	if stats.Allocs != 20 || stats.Bytes != 2000 {
+		t.Errorf("summarise() = %d allocs, %d bytes, want 20 allocs, 2000 bytes", stats.Allocs, stats.Bytes)
+	}
+}
+
+func TestStatsChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		median   time.Duration
+		baseline time.Duration
+		want     float64
+	}{
+		{"unchanged", 100, 100, 0},
+		{"slower", 150, 100, 0.5},
+		{"faster", 75, 100, -0.25},
+		{"no baseline median", 100, 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Stats{Median: test.median}.Change(Stats{Median: test.baseline})
+			if got != test.want {
+				t.Errorf("Change() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"bbuck.dev/aoc2025/config"
+)
+
+// Record is the machine readable form of a Result written by the JSON
+// output format.
+type Record struct {
+	Year        int    `json:"year"`
+	Day         int    `json:"day"`
+	Part        int    `json:"part"`
+	Input       string `json:"input"`
+	Answer      string `json:"answer,omitempty"`
+	Error       string `json:"error,omitempty"`
+	ReadTimeNS  int64  `json:"read_time_ns"`
+	ParseTimeNS int64  `json:"parse_time_ns"`
+	SolveTimeNS int64  `json:"solve_time_ns"`
+	InputSHA256 string `json:"input_sha256"`
+}
+
+// Record converts the result for JSON output.
+func (r Result) Record() Record {
+	record := Record{
+		Year:        r.Year,
+		Day:         r.Day,
+		Part:        r.Part,
+		Input:       r.Input,
+		ReadTimeNS:  r.ReadTime.Nanoseconds(),
+		ParseTimeNS: r.ParseTime.Nanoseconds(),
+		SolveTimeNS: r.SolveTime.Nanoseconds(),
+		InputSHA256: r.InputSHA256,
+	}
+
+	if r.Err != nil {
+		record.Error = r.Err.Error()
+	} else {
+		record.Answer = r.AnswerString()
+	}
+
+	return record
+}
+
+// WriteResults writes the results in the given format. JSON output is one
+// record per line for every part that was solved, parts that are not
+// implemented are left out.
+func WriteResults(w io.Writer, format config.Format, results []Result) error {
+	if format == config.FormatJSON {
+		encoder := json.NewEncoder(w)
+		for _, result := range results {
+			if result.Skipped() {
+				continue
+			}
+
+			if err := encoder.Encode(result.Record()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// answers are grouped under their input when several inputs were run
+	groups := byInput(results)
+	for _, group := range groups {
+		indent := ""
+		if len(groups) > 1 {
+			indent = "  "
+			if _, err := fmt.Fprintf(w, "%s:\n", group[0].Input); err != nil {
+				return err
+			}
+		}
+
+		for _, result := range group {
+			if _, err := fmt.Fprintf(w, "%s%s\n", indent, result); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// WriteTimings writes a summary of the time spent in each phase of the run,
+// for each input that was run.
+func WriteTimings(w io.Writer, results []Result) error {
+	groups := byInput(results)
+	for _, group := range groups {
+		label := "input"
+		if len(groups) > 1 {
+			label = fmt.Sprintf("input %s", group[0].Input)
+		}
+
+		if _, err := fmt.Fprintf(w, "read %s: %s\n", label, group[0].ReadTime); err != nil {
+			return err
+		}
+
+		for _, result := range group {
+			if result.Skipped() {
+				continue
+			}
+
+			_, err := fmt.Fprintf(w, "part %d: parse %s, solve %s\n", result.Part, result.ParseTime, result.SolveTime)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// byInput splits the results into runs of consecutive results that were
+// solved from the same input.
+func byInput(results []Result) [][]Result {
+	var groups [][]Result
+	for start := 0; start < len(results); {
+		end := start + 1
+		for end < len(results) && results[end].Input == results[start].Input {
+			end++
+		}
+
+		groups = append(groups, results[start:end])
+		start = end
+	}
+
+	return groups
+}
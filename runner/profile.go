@@ -0,0 +1,92 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+
+	"bbuck.dev/aoc2025/config"
+)
+
+// StartProfiling starts the CPU profile and execution trace requested by the
+// configuration. The returned function stops them and writes the heap
+// profile, it must be called once the run is complete. Anything already
+// started is stopped again when an error is returned.
+func StartProfiling(configuration config.Config) (func() error, error) {
+	var stops []func() error
+
+	stop := func() error {
+		var errs []error
+		for i := len(stops) - 1; i >= 0; i-- {
+			errs = append(errs, stops[i]())
+		}
+
+		return errors.Join(errs...)
+	}
+
+	if configuration.CPUProfile != "" {
+		file, err := os.Create(configuration.CPUProfile)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to create CPU profile: %w", err), stop())
+		}
+
+		if err := pprof.StartCPUProfile(file); err != nil {
+			file.Close()
+
+			return nil, errors.Join(fmt.Errorf("failed to start CPU profile: %w", err), stop())
+		}
+
+		stops = append(stops, func() error {
+			pprof.StopCPUProfile()
+
+			return file.Close()
+		})
+	}
+
+	if configuration.Trace != "" {
+		file, err := os.Create(configuration.Trace)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to create trace: %w", err), stop())
+		}
+
+		if err := trace.Start(file); err != nil {
+			file.Close()
+
+			return nil, errors.Join(fmt.Errorf("failed to start trace: %w", err), stop())
+		}
+
+		stops = append(stops, func() error {
+			trace.Stop()
+
+			return file.Close()
+		})
+	}
+
+	if configuration.MemProfile != "" {
+		stops = append(stops, func() error {
+			return writeHeapProfile(configuration.MemProfile)
+		})
+	}
+
+	return stop, nil
+}
+
+func writeHeapProfile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create heap profile: %w", err)
+	}
+	defer file.Close()
+
+	// collect garbage first so the profile reflects live memory
+	runtime.GC()
+
+	if err := pprof.WriteHeapProfile(file); err != nil {
+		return fmt.Errorf("failed to write heap profile: %w", err)
+	}
+
+	return nil
+}
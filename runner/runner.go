@@ -0,0 +1,200 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/input"
+	"bbuck.dev/aoc2025/solver"
+)
+
+// ErrTimeout is the error of a part that did not finish within the configured
+// timeout.
+var ErrTimeout = errors.New("timed out")
+
+// Result is the outcome of solving a single part of a day.
+type Result struct {
+	Year   int
+	Day    int
+	Part   int
+	Answer any
+	Err    error
+
+	// Input names the input variant, or the input path when one was given.
+	Input       string
+	InputSHA256 string
+
+	// ReadTime is shared by every part solved from the same read of the input.
+	ReadTime  time.Duration
+	ParseTime time.Duration
+	SolveTime time.Duration
+}
+
+// Skipped reports whether the part had no solution to run.
+func (r Result) Skipped() bool {
+	return errors.Is(r.Err, solver.ErrNotImplemented)
+}
+
+// TimedOut reports whether solving the part was given up on because it took
+// longer than the configured timeout.
+func (r Result) TimedOut() bool {
+	return errors.Is(r.Err, ErrTimeout)
+}
+
+// Failed reports whether solving the part returned an error.
+func (r Result) Failed() bool {
+	return r.Err != nil && !r.Skipped()
+}
+
+// AnswerString formats the answer the same way it is stored in expected
+// answer files.
+func (r Result) AnswerString() string {
+	return fmt.Sprint(r.Answer)
+}
+
+func (r Result) String() string {
+	if r.Skipped() {
+		return fmt.Sprintf("Part %d: not implemented", r.Part)
+	}
+
+	if r.TimedOut() {
+		return fmt.Sprintf("Part %d: %s", r.Part, r.Err)
+	}
+
+	if r.Err != nil {
+		return fmt.Sprintf("Part %d: error: %s", r.Part, r.Err)
+	}
+
+	return fmt.Sprintf("Part %d: %s", r.Part, r.AnswerString())
+}
+
+// Run reads the input for the configured day and solves each of the
+// configured parts with a freshly parsed solver. Failures of individual parts
+// are reported on their Result, the returned error is reserved for problems
+// that prevent any part from running. With AllInputs every input of the day
+// is run in turn.
+func Run(ctx context.Context, configuration config.Config) ([]Result, error) {
+	configurations, err := Inputs(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Result
+	for _, inputConfiguration := range configurations {
+		source, err := input.SourceFor(inputConfiguration, inputConfiguration.DayPath())
+		if err != nil {
+			return results, fmt.Errorf("failed to read input: %w", err)
+		}
+
+		inputResults, err := RunSource(ctx, inputConfiguration, source)
+		results = append(results, inputResults...)
+		if err != nil {
+			return results, err
+		}
+	}
+
+	return results, nil
+}
+
+// Inputs expands the configuration into one configuration per input that
+// should be run. Without AllInputs that is the configuration itself,
+// otherwise each input variant of the day is selected in turn.
+func Inputs(configuration config.Config) ([]config.Config, error) {
+	if !configuration.AllInputs {
+		return []config.Config{configuration}, nil
+	}
+
+	if configuration.Input != "" || configuration.Variant != "" {
+		return nil, errors.New("all inputs cannot be combined with an explicit input or variant")
+	}
+
+	variants, err := input.Variants(configuration, configuration.DayPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inputs: %w", err)
+	}
+
+	configurations := make([]config.Config, 0, len(variants))
+	for _, variant := range variants {
+		inputConfiguration := configuration
+		inputConfiguration.AllInputs = false
+		inputConfiguration.Variant = variant
+
+		configurations = append(configurations, inputConfiguration)
+	}
+
+	return configurations, nil
+}
+
+// RunSource is like Run but reads the input from the given source.
+func RunSource(ctx context.Context, configuration config.Config, source input.Source) ([]Result, error) {
+	factory, err := solver.Lookup(configuration.Year, configuration.Day)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := solver.CheckParams(configuration); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	lines, digest, err := input.ReadSourceDigest(source)
+	readTime := time.Since(start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+
+	parts := configuration.Part.Parts()
+	results := make([]Result, 0, len(parts))
+	for _, part := range parts {
+		result := Result{
+			Year:        configuration.Year,
+			Day:         configuration.Day,
+			Part:        part,
+			Input:       configuration.InputName(),
+			InputSHA256: digest,
+			ReadTime:    readTime,
+		}
+
+		daySolver := factory(configuration)
+
+		start = time.Now()
+		err := daySolver.Parse(lines)
+		result.ParseTime = time.Since(start)
+		if err != nil {
+			var parseErr *input.ParseError
+			if errors.As(err, &parseErr) && parseErr.File == "" {
+				parseErr.File = source.Name()
+			}
+
+			return results, fmt.Errorf("failed to parse input: %w", err)
+		}
+
+		start = time.Now()
+		result.Answer, result.Err = solvePart(ctx, configuration, daySolver, part)
+		result.SolveTime = time.Since(start)
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// solvePart solves the part, giving up once the configured timeout passes.
+func solvePart(ctx context.Context, configuration config.Config, daySolver solver.Solver, part int) (any, error) {
+	if configuration.Timeout <= 0 {
+		return solver.SolvePart(ctx, daySolver, part)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, configuration.Timeout)
+	defer cancel()
+
+	answer, err := solver.SolvePart(ctx, daySolver, part)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, fmt.Errorf("%w after %s", ErrTimeout, configuration.Timeout)
+	}
+
+	return answer, err
+}
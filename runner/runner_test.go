@@ -0,0 +1,42 @@
+package runner
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"bbuck.dev/aoc2025/config"
+)
+
+func TestInputsPickParameterDefaults(t *testing.T) {
+	target := config.Param[int]{Name: "target", Sample: 10, Problem: 1000}
+
+	configurations, err := Inputs(config.Config{
+		Year:      2025,
+		Day:       8,
+		AllInputs: true,
+		InputFS: fstest.MapFS{
+			"2025/day8/edge-case.in": {Data: []byte("1\n")},
+			"2025/day8/problem.in":   {Data: []byte("1\n")},
+			"2025/day8/sample.in":    {Data: []byte("1\n")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Inputs() error = %v", err)
+	}
+
+	want := map[string]int{"edge-case": 10, "problem": 1000, "sample": 10}
+	if len(configurations) != len(want) {
+		t.Fatalf("Inputs() gave %d configurations, want %d", len(configurations), len(want))
+	}
+
+	for _, configuration := range configurations {
+		got, err := target.Get(configuration)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+
+		if variant := configuration.VariantName(); got != want[variant] {
+			t.Errorf("Get() for %s = %d, want %d", variant, got, want[variant])
+		}
+	}
+}
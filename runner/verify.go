@@ -0,0 +1,182 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/input"
+)
+
+// Status is the outcome of comparing a result with its expected answer.
+type Status int
+
+const (
+	StatusPass Status = iota
+	StatusFail
+	StatusMissing
+	StatusSkipped
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusPass:
+		return "PASS"
+
+	case StatusFail:
+		return "FAIL"
+
+	case StatusMissing:
+		return "MISSING"
+
+	default:
+		return "SKIP"
+	}
+}
+
+// Check pairs a result with the answer stored for it.
+type Check struct {
+	Result
+
+	Expected string
+	Status   Status
+}
+
+// Diff describes how the computed answer differs from the expected one, line
+// by line. It is empty unless the check failed.
+func (c Check) Diff() string {
+	if c.Status != StatusFail {
+		return ""
+	}
+
+	if c.Err != nil && c.Expected == "" {
+		return fmt.Sprintf("! error: %s\n", c.Err)
+	}
+
+	if c.Err != nil {
+		return fmt.Sprintf("- %s\n! error: %s\n", c.Expected, c.Err)
+	}
+
+	var (
+		builder  = new(strings.Builder)
+		expected = strings.Split(c.Expected, "\n")
+		actual   = strings.Split(c.AnswerString(), "\n")
+	)
+	for i := range max(len(expected), len(actual)) {
+		var expectedLine, actualLine string
+		if i < len(expected) {
+			expectedLine = expected[i]
+		}
+
+		if i < len(actual) {
+			actualLine = actual[i]
+		}
+
+		if expectedLine == actualLine {
+			builder.WriteString("  ")
+			builder.WriteString(expectedLine)
+			builder.WriteRune('\n')
+
+			continue
+		}
+
+		if i < len(expected) {
+			builder.WriteString("- ")
+			builder.WriteString(expectedLine)
+			builder.WriteRune('\n')
+		}
+
+		if i < len(actual) {
+			builder.WriteString("+ ")
+			builder.WriteString(actualLine)
+			builder.WriteRune('\n')
+		}
+	}
+
+	return builder.String()
+}
+
+func (c Check) String() string {
+	label := fmt.Sprintf("%s %d day %d part %d (%s)", c.Status, c.Year, c.Day, c.Part, c.Input)
+
+	switch c.Status {
+	case StatusFail:
+		return label + "\n" + strings.TrimSuffix(c.Diff(), "\n")
+
+	case StatusMissing:
+		return label + ": no expected answer stored"
+
+	case StatusSkipped:
+		return label + ": not implemented"
+
+	default:
+		return label
+	}
+}
+
+// Verify runs the configured day and compares every answer with the expected
+// answer file stored beside the input, like "2025/day5/sample.part2.out". With
+// AllInputs every input of the day is verified.
+func Verify(ctx context.Context, configuration config.Config) ([]Check, error) {
+	configurations, err := Inputs(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	var checks []Check
+	for _, inputConfiguration := range configurations {
+		inputChecks, err := verifyInput(ctx, inputConfiguration)
+		checks = append(checks, inputChecks...)
+		if err != nil {
+			return checks, err
+		}
+	}
+
+	return checks, nil
+}
+
+func verifyInput(ctx context.Context, configuration config.Config) ([]Check, error) {
+	source, err := input.SourceFor(configuration, configuration.DayPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+
+	results, err := RunSource(ctx, configuration, source)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]Check, 0, len(results))
+	for _, result := range results {
+		check := Check{Result: result}
+
+		expected, found, err := input.ExpectedAnswer(source, result.Part)
+		if err != nil {
+			return checks, fmt.Errorf("failed to read expected answer for part %d: %w", result.Part, err)
+		}
+
+		check.Expected = expected
+		switch {
+		case result.Skipped():
+			check.Status = StatusSkipped
+
+		// an error fails the part even when there is nothing to compare to
+		case result.Err != nil:
+			check.Status = StatusFail
+
+		case !found:
+			check.Status = StatusMissing
+
+		case result.AnswerString() == expected:
+			check.Status = StatusPass
+
+		default:
+			check.Status = StatusFail
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks, nil
+}
@@ -0,0 +1,139 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"bbuck.dev/aoc2025/config"
+	"bbuck.dev/aoc2025/solver"
+)
+
+// echoSolver answers part 1 with the first line of its input and does not
+// implement part 2.
+type echoSolver struct {
+	lines []string
+}
+
+func (s *echoSolver) Parse(lines []string) error {
+	s.lines = lines
+
+	return nil
+}
+
+func (s *echoSolver) SolvePart1(context.Context) (any, error) {
+	return s.lines[0], nil
+}
+
+func (s *echoSolver) SolvePart2(context.Context) (any, error) {
+	return nil, solver.ErrNotImplemented
+}
+
+// failingSolver fails part 1 and answers part 2.
+type failingSolver struct{}
+
+func (failingSolver) Parse([]string) error {
+	return nil
+}
+
+func (failingSolver) SolvePart1(context.Context) (any, error) {
+	return nil, errors.New("graph does not have node svr")
+}
+
+func (failingSolver) SolvePart2(context.Context) (any, error) {
+	return 7, nil
+}
+
+// testYear keeps the stub solvers away from the registered days.
+const testYear = 1999
+
+func init() {
+	solver.Register(testYear, 1, func(config.Config) solver.Solver {
+		return new(echoSolver)
+	})
+	solver.Register(testYear, 2, func(config.Config) solver.Solver {
+		return failingSolver{}
+	})
+}
+
+func verifyStatuses(t *testing.T, day int, inputs fstest.MapFS) map[string]Check {
+	t.Helper()
+
+	checks, err := Verify(t.Context(), config.Config{
+		Year:      testYear,
+		Day:       day,
+		Part:      config.PartBoth,
+		AllInputs: true,
+		InputFS:   inputs,
+	})
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	byName := make(map[string]Check, len(checks))
+	for _, check := range checks {
+		byName[check.Input+" part "+string(rune('0'+check.Part))] = check
+	}
+
+	return byName
+}
+
+func TestVerify(t *testing.T) {
+	checks := verifyStatuses(t, 1, fstest.MapFS{
+		"1999/day1/pass.in":         {Data: []byte("42\n")},
+		"1999/day1/pass.part1.out":  {Data: []byte("42\n")},
+		"1999/day1/fail.in":         {Data: []byte("41\n")},
+		"1999/day1/fail.part1.out":  {Data: []byte("42\n")},
+		"1999/day1/empty.in":        {Data: []byte("1\n")},
+		"1999/day1/empty.part1.out": {Data: []byte("\n")},
+	})
+
+	tests := []struct {
+		name   string
+		status Status
+		diff   string
+	}{
+		{"pass part 1", StatusPass, ""},
+		{"pass part 2", StatusSkipped, ""},
+		{"fail part 1", StatusFail, "- 42\n+ 41\n"},
+		{"empty part 1", StatusMissing, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			check, ok := checks[test.name]
+			if !ok {
+				t.Fatalf("no check for %s", test.name)
+			}
+
+			if check.Status != test.status {
+				t.Errorf("status = %s, want %s", check.Status, test.status)
+			}
+
+			if diff := check.Diff(); diff != test.diff {
+				t.Errorf("Diff() = %q, want %q", diff, test.diff)
+			}
+		})
+	}
+}
+
+func TestVerifyErrorWithoutExpectedAnswer(t *testing.T) {
+	checks := verifyStatuses(t, 2, fstest.MapFS{
+		"1999/day2/sample.in": {Data: []byte("x\n")},
+	})
+
+	failed := checks["sample part 1"]
+	if failed.Status != StatusFail {
+		t.Errorf("erroring part status = %s, want FAIL", failed.Status)
+	}
+
+	if text := failed.String(); !strings.Contains(text, "! error: graph does not have node svr") {
+		t.Errorf("String() = %q, want the error shown", text)
+	}
+
+	if missing := checks["sample part 2"]; missing.Status != StatusMissing {
+		t.Errorf("answered part without an expected answer status = %s, want MISSING", missing.Status)
+	}
+}
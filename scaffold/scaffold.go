@@ -0,0 +1,265 @@
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// RegistryFile is where the imports that register every day live, relative
+// to the module root.
+const RegistryFile = "cmds/aoc/days.go"
+
+var (
+	yearDirPattern    = regexp.MustCompile(`^\d{4}$`)
+	dayPackagePattern = regexp.MustCompile(`^day\d+$`)
+)
+
+// Generator creates the files for a new day inside a module.
+type Generator struct {
+	// Root is the directory containing go.mod.
+	Root string
+	// Module is the module path declared in go.mod.
+	Module string
+	// InputDir is the inputs directory, relative to Root unless absolute.
+	InputDir string
+}
+
+// NewGenerator creates a generator for the module containing dir.
+func NewGenerator(dir, inputDir string) (*Generator, error) {
+	root, err := FindModuleRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+
+	var module string
+	for line := range strings.Lines(string(contents)) {
+		if rest, found := strings.CutPrefix(line, "module "); found {
+			module = strings.TrimSpace(rest)
+
+			break
+		}
+	}
+
+	if module == "" {
+		return nil, errors.New("go.mod does not declare a module")
+	}
+
+	return &Generator{
+		Root:     root,
+		Module:   module,
+		InputDir: inputDir,
+	}, nil
+}
+
+// FindModuleRoot walks up from dir to the first directory holding a go.mod.
+func FindModuleRoot(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errors.New("not inside a Go module")
+		}
+
+		dir = parent
+	}
+}
+
+type dayData struct {
+	Module              string
+	Package             string
+	Year                int
+	Day                 int
+	InputDirFromPackage string
+}
+
+// Generate creates the solver package, its test, the sample input and the
+// expected answer placeholders for the day of the year, then registers the
+// day with the runner. Existing files are left untouched and only created
+// paths are returned.
+func (g *Generator) Generate(year, day int) ([]string, error) {
+	if day < 1 {
+		return nil, fmt.Errorf("invalid day %d", day)
+	}
+
+	if !yearDirPattern.MatchString(strconv.Itoa(year)) {
+		return nil, fmt.Errorf("invalid year %d", year)
+	}
+
+	packageName := fmt.Sprintf("day%d", day)
+	packageDir := filepath.Join(g.Root, "days", strconv.Itoa(year), packageName)
+	solverPath := filepath.Join(packageDir, packageName+".go")
+
+	if _, err := os.Stat(solverPath); err == nil {
+		return nil, fmt.Errorf("%s already exists", solverPath)
+	}
+
+	inputDir := g.InputDir
+	if !filepath.IsAbs(inputDir) {
+		inputDir = filepath.Join(g.Root, inputDir)
+	}
+
+	inputDirFromPackage, err := filepath.Rel(packageDir, inputDir)
+	if err != nil {
+		return nil, err
+	}
+
+	data := dayData{
+		Module:              g.Module,
+		Package:             packageName,
+		Year:                year,
+		Day:                 day,
+		InputDirFromPackage: filepath.ToSlash(inputDirFromPackage),
+	}
+
+	var created []string
+
+	files := []struct {
+		path     string
+		template string
+	}{
+		{solverPath, "day.go.tmpl"},
+		{filepath.Join(packageDir, packageName+"_test.go"), "day_test.go.tmpl"},
+	}
+	for _, file := range files {
+		contents, err := render(file.template, data)
+		if err != nil {
+			return created, err
+		}
+
+		wrote, err := writeNew(file.path, contents)
+		if err != nil {
+			return created, err
+		}
+
+		if wrote {
+			created = append(created, file.path)
+		}
+	}
+
+	for _, name := range []string{"sample.in", "sample.part1.out", "sample.part2.out"} {
+		path := filepath.Join(inputDir, strconv.Itoa(year), packageName, name)
+
+		wrote, err := writeNew(path, nil)
+		if err != nil {
+			return created, err
+		}
+
+		if wrote {
+			created = append(created, path)
+		}
+	}
+
+	if err := g.WriteRegistry(); err != nil {
+		return created, err
+	}
+
+	return created, nil
+}
+
+// WriteRegistry regenerates the registry file so it imports every day
+// package found in the year directories under the days directory, like
+// "days/2025/day5".
+func (g *Generator) WriteRegistry() error {
+	years, err := os.ReadDir(filepath.Join(g.Root, "days"))
+	if err != nil {
+		return err
+	}
+
+	var packages []string
+	for _, year := range years {
+		if !year.IsDir() || !yearDirPattern.MatchString(year.Name()) {
+			continue
+		}
+
+		entries, err := os.ReadDir(filepath.Join(g.Root, "days", year.Name()))
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() && dayPackagePattern.MatchString(entry.Name()) {
+				packages = append(packages, path.Join(year.Name(), entry.Name()))
+			}
+		}
+	}
+
+	// sorted the same way gofmt sorts imports
+	slices.Sort(packages)
+
+	contents, err := render("days.go.tmpl", map[string]any{
+		"Module":   g.Module,
+		"Packages": packages,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(g.Root, RegistryFile), contents, 0o644)
+}
+
+func render(name string, data any) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	if err := templates.ExecuteTemplate(buffer, name, data); err != nil {
+		return nil, fmt.Errorf("failed to render %s: %w", name, err)
+	}
+
+	if !strings.HasSuffix(name, ".go.tmpl") {
+		return buffer.Bytes(), nil
+	}
+
+	return format.Source(buffer.Bytes())
+}
+
+// writeNew creates the file with the given contents unless it already exists,
+// reporting whether it was written.
+func writeNew(path string, contents []byte) (bool, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return false, err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if errors.Is(err, os.ErrExist) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := file.Write(contents); err != nil {
+		file.Close()
+
+		return false, err
+	}
+
+	return true, file.Close()
+}
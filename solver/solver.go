@@ -0,0 +1,134 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"bbuck.dev/aoc2025/config"
+)
+
+// ErrNotImplemented is returned by a Solver for a part that has no solution.
+var ErrNotImplemented = errors.New("part is not implemented")
+
+// Solver is implemented by every day. A fresh Solver is created for each part
+// that is run so parts are free to mutate their parsed state.
+type Solver interface {
+	// Parse loads the puzzle input into the solver.
+	Parse(lines []string) error
+
+	// SolvePart1 returns the answer to the first part of the puzzle. Long
+	// running searches should stop with the context's error once it is done.
+	SolvePart1(ctx context.Context) (any, error)
+
+	// SolvePart2 returns the answer to the second part of the puzzle.
+	SolvePart2(ctx context.Context) (any, error)
+}
+
+// Factory creates a new Solver for the given configuration.
+type Factory func(configuration config.Config) Solver
+
+// puzzle identifies a day of an event.
+type puzzle struct {
+	year, day int
+}
+
+var (
+	registry = make(map[puzzle]Factory)
+	params   = make(map[puzzle][]config.ParamDecl)
+)
+
+// Register makes a day's solver available to the runner. It is meant to be
+// called from the init function of the day's package and panics if the day
+// has already been registered for the year. The puzzle parameters the solver
+// reads are declared alongside it.
+func Register(year, day int, factory Factory, declared ...config.ParamDecl) {
+	key := puzzle{year, day}
+	if _, exists := registry[key]; exists {
+		panic(fmt.Errorf("%d day %d registered twice", year, day))
+	}
+
+	registry[key] = factory
+	params[key] = declared
+}
+
+// Lookup returns the factory registered for the given day of the year.
+func Lookup(year, day int) (Factory, error) {
+	factory, exists := registry[puzzle{year, day}]
+	if !exists {
+		return nil, fmt.Errorf("no solver registered for %d day %d", year, day)
+	}
+
+	return factory, nil
+}
+
+// Years returns every year with a registered day in ascending order.
+func Years() []int {
+	years := make(map[int]bool)
+	for key := range registry {
+		years[key.year] = true
+	}
+
+	return slices.Sorted(maps.Keys(years))
+}
+
+// Days returns the registered days of the year in ascending order.
+func Days(year int) []int {
+	var days []int
+	for key := range registry {
+		if key.year == year {
+			days = append(days, key.day)
+		}
+	}
+
+	slices.Sort(days)
+
+	return days
+}
+
+// Params returns the puzzle parameters declared by the solver for the day of
+// the year.
+func Params(year, day int) []config.ParamDecl {
+	return params[puzzle{year, day}]
+}
+
+// CheckParams returns an error when a parameter value given for the
+// configured day is not declared by its solver or cannot be parsed.
+func CheckParams(configuration config.Config) error {
+	declared := make(map[string]config.ParamDecl)
+	for _, param := range Params(configuration.Year, configuration.Day) {
+		declared[param.ParamName()] = param
+	}
+
+	for _, name := range configuration.Params.Names(configuration.DayPath()) {
+		if _, ok := declared[name]; !ok {
+			known := slices.Sorted(maps.Keys(declared))
+
+			return fmt.Errorf("%d day %d has no parameter %q, it has %v", configuration.Year, configuration.Day, name, known)
+		}
+	}
+
+	for _, param := range Params(configuration.Year, configuration.Day) {
+		if err := param.Check(configuration); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SolvePart dispatches to the solve method for the given part.
+func SolvePart(ctx context.Context, s Solver, part int) (any, error) {
+	switch part {
+	case 1:
+		return s.SolvePart1(ctx)
+
+	case 2:
+		return s.SolvePart2(ctx)
+
+	default:
+		return nil, fmt.Errorf("unknown part %d", part)
+	}
+}
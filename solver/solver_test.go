@@ -0,0 +1,97 @@
+package solver
+
+import (
+	"context"
+	"flag"
+	"strings"
+	"testing"
+
+	"bbuck.dev/aoc2025/config"
+)
+
+type stubSolver struct{}
+
+func (stubSolver) Parse([]string) error {
+	return nil
+}
+
+func (stubSolver) SolvePart1(context.Context) (any, error) {
+	return 1, nil
+}
+
+func (stubSolver) SolvePart2(context.Context) (any, error) {
+	return nil, ErrNotImplemented
+}
+
+// testYear keeps the stub away from the registered days.
+const testYear = 1999
+
+func init() {
+	Register(testYear, 1, func(config.Config) Solver {
+		return stubSolver{}
+	}, config.Param[int]{Name: "size", Sample: 1, Problem: 2})
+}
+
+func configFor(t *testing.T, args ...string) config.Config {
+	t.Helper()
+
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	configuration := config.Bind(flags)
+	if err := flags.Parse(append([]string{"-year", "1999", "-day", "1"}, args...)); err != nil {
+		t.Fatal(err)
+	}
+
+	return *configuration
+}
+
+func TestCheckParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"no values", nil, ""},
+		{"declared value", []string{"-param", "size=5"}, ""},
+		{"unknown name", []string{"-param", "sise=5"}, `has no parameter "sise", it has [size]`},
+		{"unparsable value", []string{"-param", "size=big"}, `invalid value "big" for parameter size`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckParams(configFor(t, test.args...))
+			if test.wantErr == "" {
+				if err != nil {
+					t.Errorf("CheckParams() error = %v, want nil", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("CheckParams() error = %v, want it to mention %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	if _, err := Lookup(testYear, 1); err != nil {
+		t.Errorf("Lookup() error = %v", err)
+	}
+
+	if _, err := Lookup(testYear, 2); err == nil {
+		t.Error("Lookup() of an unregistered day error = nil, want an error")
+	}
+
+	if days := Days(testYear); len(days) != 1 || days[0] != 1 {
+		t.Errorf("Days() = %v, want [1]", days)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("registering a day twice did not panic")
+		}
+	}()
+
+	Register(testYear, 1, nil)
+}